@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -13,14 +14,18 @@ import (
 	_ "github.com/daniilsolovey/news-portal/docs"
 	"github.com/daniilsolovey/news-portal/internal/app"
 	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
 	"github.com/go-pg/pg/v10"
 )
 
 var (
-	flConfig = flag.String("config", "config.toml", "path to TOML configuration file")
-	flDebug  = flag.Bool("debug", false, "enable debug mode")
-	cfg      app.Config
-	lg       *slog.Logger
+	flConfig  = flag.String("config", "config.toml", "path to TOML configuration file")
+	flDebug   = flag.Bool("debug", false, "enable debug mode")
+	flToken   = flag.String("token", "", "print JWT token with role (reader, editor or admin) valid for 24 hours and exit")
+	flPrint   = flag.Bool("print-config", false, "print effective configuration with redacted secrets and exit")
+	flMigrate = flag.Bool("migrate", false, "apply pending migrations before start")
+	cfg       app.Config
+	lg        *slog.Logger
 )
 
 // @title News Portal API
@@ -36,19 +41,68 @@ func main() {
 	loadConfig()
 	ctx := context.Background()
 
-	dbConnection := pg.Connect(&cfg.Database)
-	err := dbConnection.Ping(ctx)
-	exitOnError(err)
-	defer dbConnection.Close()
-	database := db.New(dbConnection)
+	if *flPrint {
+		printConfig()
+		return
+	}
+
+	if *flToken != "" {
+		printToken(*flToken)
+		return
+	}
+
+	if cmd := flag.Arg(0); cmd == "migrate" {
+		exitOnError(runMigrate(ctx, flag.Args()[1:]))
+		return
+	} else if cmd != "" {
+		exitOnError(fmt.Errorf("unknown command %q", cmd))
+	}
+
+	database := connectDB(ctx)
+	defer database.Close()
+
+	if *flMigrate {
+		exitOnError(migrateUp(ctx, database))
+	}
 
 	service := app.New(cfg, database, lg)
 	runServer(ctx, service)
 }
 
+// loadConfig reads config file, overrides it with environment variables and validates it.
 func loadConfig() {
 	_, err := toml.DecodeFile(*flConfig, &cfg)
 	exitOnError(err)
+
+	exitOnError(cfg.LoadEnv(app.EnvPrefix))
+	exitOnError(cfg.Validate())
+}
+
+// connectDB connects to configured database and checks connection.
+func connectDB(ctx context.Context) db.DB {
+	opts, err := cfg.Database.Options()
+	exitOnError(err)
+
+	dbConnection := pg.Connect(opts)
+	err = dbConnection.Ping(ctx)
+	exitOnError(err)
+
+	return db.New(dbConnection)
+}
+
+// printConfig prints effective config in TOML, passwords and secrets are redacted.
+func printConfig() {
+	err := toml.NewEncoder(os.Stdout).Encode(cfg.Redacted())
+	exitOnError(err)
+}
+
+// printToken prints JWT token signed with configured secret, e.g. to create the first admin API key.
+func printToken(role string) {
+	auth := newsportal.NewAuthManager(nil, cfg.Auth)
+	token, err := auth.NewToken("cli", newsportal.Role(role), 24*time.Hour)
+	exitOnError(err)
+
+	fmt.Println(token)
 }
 
 func runServer(appCtx context.Context, service *app.App) {
@@ -68,7 +122,7 @@ func runServer(appCtx context.Context, service *app.App) {
 	<-signalCtx.Done()
 	lg.Info("service stopping")
 
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), app.ShutdownTimeout)
 	defer cancel()
 
 	if err := service.GracefulShutdown(shutdownCtx); err != nil {
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/docs/patches"
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/pressly/goose/v3"
+)
+
+const migrateUsage = "usage: news-portal [flags] migrate up|down|status|create NAME"
+
+// migrationsDir is a source directory of embedded migrations, new migrations are created in it.
+var migrationsDir = filepath.Join("docs", "patches", patches.Dir)
+
+// runMigrate runs migrate subcommand with args, e.g. ["up"] or ["create", "add_comments"].
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 2 && args[0] == "create" {
+		return goose.Create(nil, migrationsDir, args[1], "sql")
+	} else if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	database := connectDB(ctx)
+	defer database.Close()
+
+	switch args[0] {
+	case "up":
+		return migrateUp(ctx, database)
+	case "down":
+		return migrateDown(ctx, database)
+	case "status":
+		return printMigrationStatus(ctx, database)
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+// migrateUp applies pending embedded migrations.
+func migrateUp(ctx context.Context, database db.DB) error {
+	m, err := db.NewMigrator(database, cfg.Database.DSN(), patches.Migrations())
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	results, err := m.Up(ctx)
+	for _, r := range results {
+		lg.Info("migration applied", "version", r.Source.Version, "path", r.Source.Path, "duration", r.Duration)
+	}
+	if err != nil {
+		return fmt.Errorf("migrate up: %w", err)
+	}
+
+	lg.Info("migrations are up to date", "applied", len(results))
+	return nil
+}
+
+// migrateDown rolls back the latest applied migration.
+func migrateDown(ctx context.Context, database db.DB) error {
+	m, err := db.NewMigrator(database, cfg.Database.DSN(), patches.Migrations())
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	r, err := m.Down(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate down: %w", err)
+	}
+
+	lg.Info("migration rolled back", "version", r.Source.Version, "path", r.Source.Path, "duration", r.Duration)
+	return nil
+}
+
+// printMigrationStatus prints embedded migrations with their state and applied time.
+func printMigrationStatus(ctx context.Context, database db.DB) error {
+	m, err := db.NewMigrator(database, cfg.Database.DSN(), patches.Migrations())
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate status: %w", err)
+	}
+
+	fmt.Printf("%-25s %s\n", "Applied At", "Migration")
+	for _, s := range statuses {
+		appliedAt := "Pending"
+		if s.State == goose.StateApplied {
+			appliedAt = s.AppliedAt.UTC().Format(time.DateTime)
+		}
+		fmt.Printf("%-25s %s\n", appliedAt, s.Source.Path)
+	}
+
+	return nil
+}
@@ -2,7 +2,7 @@
 -- +goose StatementBegin
 
 -- Insert statuses
-INSERT INTO "statuses" ("statusId") VALUES (1), (2), (3);
+INSERT INTO "statuses" ("statusId") VALUES (1), (2), (3) ON CONFLICT DO NOTHING;
 
 -- Insert categories (5 categories)
 INSERT INTO "categories" ("title", "orderNumber", "statusId") VALUES
@@ -70,7 +70,6 @@ INSERT INTO "news" ("categoryId", "title", "content", "author", "publishedAt", "
 DELETE FROM "news";
 DELETE FROM "tags";
 DELETE FROM "categories";
-DELETE FROM "statuses";
 
 -- +goose StatementEnd
 
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- searchVector contains both russian and english lexemes, so the query side
+-- can use either text search configuration.
+ALTER TABLE "news" ADD COLUMN "searchVector" tsvector GENERATED ALWAYS AS (
+	setweight(to_tsvector('russian', "title"), 'A') ||
+	setweight(to_tsvector('russian', coalesce("content", '')), 'B') ||
+	setweight(to_tsvector('english', "title"), 'A') ||
+	setweight(to_tsvector('english', coalesce("content", '')), 'B')
+) STORED;
+
+CREATE INDEX "IX_news_searchVector" ON "news" USING GIN ("searchVector");
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS "IX_news_searchVector";
+ALTER TABLE "news" DROP COLUMN IF EXISTS "searchVector";
+
+-- +goose StatementEnd
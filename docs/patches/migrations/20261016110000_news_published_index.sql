@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- supports keyset pagination of news sorted by publishedAt DESC, newsId DESC
+CREATE INDEX "IX_news_publishedAt_newsId" ON "news" ("publishedAt" DESC, "newsId" DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS "IX_news_publishedAt_newsId";
+
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- API keys are stored as sha256 hashes, role is one of reader, editor, admin
+CREATE TABLE "apiKeys" (
+	"apiKeyId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"title" varchar(255) NOT NULL,
+	"keyHash" varchar(64) NOT NULL,
+	"role" varchar(16) NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"expiresAt" timestamp with time zone,
+	"statusId" int4 NOT NULL,
+	PRIMARY KEY("apiKeyId"),
+	CONSTRAINT "CHK_apiKeys_role" CHECK ("role" IN ('reader', 'editor', 'admin'))
+);
+
+CREATE UNIQUE INDEX "UQ_apiKeys_keyHash" ON "apiKeys" ("keyHash");
+
+ALTER TABLE "apiKeys" ADD CONSTRAINT "Ref_apiKeys_to_statuses" FOREIGN KEY ("statusId")
+	REFERENCES "statuses"("statusId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "apiKeys";
+
+-- +goose StatementEnd
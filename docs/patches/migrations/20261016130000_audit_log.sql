@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- audit log of editorial changes, diff contains changed columns as {"column": {"old": ..., "new": ...}}
+CREATE TABLE "auditLog" (
+	"auditLogId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"actor" varchar(255) NOT NULL,
+	"entity" varchar(32) NOT NULL,
+	"entityId" int4 NOT NULL,
+	"action" varchar(16) NOT NULL,
+	"diff" jsonb NOT NULL DEFAULT '{}',
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("auditLogId")
+);
+
+CREATE INDEX "IX_auditLog_entity_entityId" ON "auditLog" ("entity", "entityId");
+CREATE INDEX "IX_auditLog_createdAt" ON "auditLog" ("createdAt");
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "auditLog";
+
+-- +goose StatementEnd
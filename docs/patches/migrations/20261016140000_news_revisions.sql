@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- news revisions are snapshots of editable news content saved on every create and update
+CREATE TABLE "newsRevisions" (
+	"newsRevisionId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"newsId" int4 NOT NULL,
+	"categoryId" int4 NOT NULL,
+	"title" varchar(255) NOT NULL,
+	"content" text,
+	"tagIds" int4[] NOT NULL DEFAULT '{}',
+	"actor" varchar(255) NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("newsRevisionId")
+);
+
+CREATE INDEX "IX_newsRevisions_newsId" ON "newsRevisions" ("newsId", "createdAt" DESC);
+
+ALTER TABLE "newsRevisions" ADD CONSTRAINT "Ref_newsRevisions_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+-- initial revisions of existing news
+INSERT INTO "newsRevisions" ("newsId", "categoryId", "title", "content", "tagIds", "actor", "createdAt")
+SELECT "newsId", "categoryId", "title", "content", "tagIds", 'migration', coalesce("updatedAt", "publishedAt")
+FROM "news"
+WHERE "statusId" <> 3;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "newsRevisions";
+
+-- +goose StatementEnd
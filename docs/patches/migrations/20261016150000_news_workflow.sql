@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- news workflow statuses: 1 - published, 4 - draft, 5 - in review, 6 - scheduled, 7 - archived
+INSERT INTO "statuses" ("statusId") VALUES (4), (5), (6), (7) ON CONFLICT DO NOTHING;
+
+-- disabled news become drafts, published news with publishedAt in the future become scheduled
+UPDATE "news" SET "statusId" = 4 WHERE "statusId" = 2;
+UPDATE "news" SET "statusId" = 6 WHERE "statusId" = 1 AND "publishedAt" > now();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE "news" SET "statusId" = 1 WHERE "statusId" = 6;
+UPDATE "news" SET "statusId" = 2 WHERE "statusId" IN (4, 5, 7);
+
+DELETE FROM "statuses" WHERE "statusId" IN (4, 5, 6, 7);
+
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- webhook subscriptions, payloads are signed with secret
+CREATE TABLE "webhooks" (
+	"webhookId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"url" varchar(2048) NOT NULL,
+	"secret" varchar(255) NOT NULL,
+	"eventTypes" varchar(32)[] NOT NULL DEFAULT '{}',
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"statusId" int4 NOT NULL,
+	PRIMARY KEY("webhookId")
+);
+
+ALTER TABLE "webhooks" ADD CONSTRAINT "Ref_webhooks_to_statuses" FOREIGN KEY ("statusId")
+	REFERENCES "statuses"("statusId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+-- outbox of webhook deliveries, written in transaction of news change;
+-- state is pending until delivered or dead after the last failed attempt
+CREATE TABLE "webhookDeliveries" (
+	"webhookDeliveryId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"webhookId" int4 NOT NULL,
+	"eventType" varchar(32) NOT NULL,
+	"payload" jsonb NOT NULL,
+	"state" varchar(16) NOT NULL DEFAULT 'pending',
+	"attempts" int4 NOT NULL DEFAULT 0,
+	"nextAttemptAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"lastError" text,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"deliveredAt" timestamp with time zone,
+	PRIMARY KEY("webhookDeliveryId"),
+	CONSTRAINT "CHK_webhookDeliveries_state" CHECK ("state" IN ('pending', 'delivered', 'dead'))
+);
+
+CREATE INDEX "IX_webhookDeliveries_webhookId" ON "webhookDeliveries" ("webhookId", "createdAt" DESC);
+CREATE INDEX "IX_webhookDeliveries_pending" ON "webhookDeliveries" ("nextAttemptAt") WHERE "state" = 'pending';
+
+ALTER TABLE "webhookDeliveries" ADD CONSTRAINT "Ref_webhookDeliveries_to_webhooks" FOREIGN KEY ("webhookId")
+	REFERENCES "webhooks"("webhookId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS "webhookDeliveries";
+DROP TABLE IF EXISTS "webhooks";
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- base statuses: 1 - enabled (published), 2 - disabled, 3 - deleted; workflow statuses are added by news_workflow
+INSERT INTO "statuses" ("statusId") VALUES (1), (2), (3) ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM "statuses" WHERE "statusId" IN (1, 2, 3);
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+// Package patches contains database migrations in goose format. Only schema migrations are embedded,
+// test data of integration tests is in integrationtests directory.
+package patches
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// Dir is a directory of migrations relative to this package, new migrations are created in it.
+const Dir = "migrations"
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations returns file system with migrations in its root.
+func Migrations() fs.FS {
+	fsys, err := fs.Sub(migrations, Dir)
+	if err != nil {
+		panic(err)
+	}
+
+	return fsys
+}
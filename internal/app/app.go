@@ -2,17 +2,24 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/daniilsolovey/news-portal/docs/patches"
 	db "github.com/daniilsolovey/news-portal/internal/db"
 	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/daniilsolovey/news-portal/internal/rest"
 	"github.com/daniilsolovey/news-portal/internal/rpc"
-	"github.com/go-pg/pg/v10"
 	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+	middleware "github.com/vmkteam/zenrpc-middleware"
 	"github.com/vmkteam/zenrpc/v2"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type App struct {
@@ -20,58 +27,209 @@ type App struct {
 	Logger *slog.Logger
 	Echo   *echo.Echo
 	Config Config
-}
 
-type Config struct {
-	Database pg.Options
-	App      struct {
-		Host string
-		Port int
-	}
+	health  *Health
+	workers []*Worker
+	stream  *newsportal.NewsStream
+	ws      *rpc.WSHandler
+	metrics *Metrics
+	// metricsServer serves metrics on MetricsConfig.Addr
+	metricsServer  *http.Server
+	tracerProvider *sdktrace.TracerProvider
 }
 
 func New(cfg Config, database db.DB, logger *slog.Logger) *App {
-	// for rest api:	// handler := rest.NewNewsHandler(newsportal.NewNewsManager(database),logger,)
-	newsManager := newsportal.NewNewsManager(database)
-	rpcServer := rpc.New(logger, newsManager)
+	newsManager := newsportal.NewNewsManager(database).WithSearchConfig(cfg.Search)
+	if cfg.Cache.Enabled {
+		newsManager.WithCache(newsportal.NewMemoryCache(cfg.Cache.Size), cfg.Cache)
+	}
+	authManager := newsportal.NewAuthManager(database, cfg.Auth)
+	if !authManager.Enabled() {
+		logger.Warn("auth is disabled, reads are public, write and admin methods are forbidden")
+	}
+	var webhookManager *newsportal.WebhookManager
+	if cfg.Webhooks.Enabled {
+		webhookManager = newsportal.NewWebhookManager(database, cfg.Webhooks)
+	}
+	var stream *newsportal.NewsStream
+	if cfg.Stream.Enabled {
+		stream = newsportal.NewNewsStream(newsManager, database.DB, logger, cfg.Stream)
+	}
+	var (
+		metrics        *Metrics
+		rpcMw          []zenrpc.MiddlewareFunc
+		tracerProvider *sdktrace.TracerProvider
+	)
+	if cfg.Tracing.Enabled {
+		tp, err := NewTracerProvider(context.Background(), cfg.Tracing)
+		if err != nil {
+			logger.Error("tracing is disabled", "error", err)
+		} else {
+			tracerProvider = tp
+			database.DB.AddQueryHook(NewTracingHook())
+			rpcMw = append(rpcMw, RPCTracing())
+		}
+	}
+	if cfg.Metrics.Enabled {
+		metrics = NewMetrics()
+		metrics.RegisterDB(database.DB)
+		metrics.RegisterCache(newsManager)
+		rpcMw = append(rpcMw, middleware.WithMetrics("news-portal"))
+	}
+
+	schemaVersion, err := db.LatestMigration(patches.Migrations())
+	if err != nil {
+		logger.Error("failed to get schema version, migrations are not checked", "error", err)
+	}
 
 	a := &App{
-		DB:     database,
-		Logger: logger,
-		Config: cfg,
+		DB:      database,
+		Logger:  logger,
+		Config:  cfg,
+		health:  NewHealth(database, schemaVersion, cfg.Health),
+		stream:  stream,
+		metrics: metrics,
+
+		tracerProvider: tracerProvider,
 	}
 
-	a.setupRoutes(rpcServer)
+	// REST and RPC share managers, so caches and stream subscriptions are shared too
+	var (
+		rpcServer   *zenrpc.Server
+		restHandler *rest.NewsHandler
+	)
+	if cfg.RPC.Enabled {
+		rpcServer = rpc.New(logger, newsManager, authManager, webhookManager, stream, rpcMw...)
+		a.ws = rpc.NewWSHandler(rpcServer, logger)
+	}
+	if cfg.REST.Enabled {
+		restHandler = rest.NewNewsHandler(newsManager, logger).WithAuth(authManager).WithHTTPCache(cfg.HTTPCache).WithStream(stream)
+	}
+	if rpcServer == nil && restHandler == nil {
+		logger.Warn("both REST and RPC are disabled, only frontend is served")
+	}
+
+	if cfg.Scheduler.Enabled {
+		a.workers = append(a.workers, NewScheduler(database, newsManager, logger, cfg.Scheduler.Interval))
+	}
+	if webhookManager != nil {
+		a.workers = append(a.workers, NewWebhookWorker(database, webhookManager, logger))
+	}
+
+	a.setupRoutes(rpcServer, restHandler, authManager)
 
 	return a
 }
 
 func (a *App) Run(ctx context.Context, port int) error {
+	for _, w := range a.workers {
+		w.Start(ctx)
+	}
+	if a.stream != nil {
+		a.stream.Start(ctx)
+	}
+	if a.metricsServer != nil {
+		go func() {
+			if err := a.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				a.Logger.Error("metrics server failed", "error", err)
+			}
+		}()
+	}
+
 	addr := fmt.Sprintf(":%d", port)
 	return a.Echo.Start(addr)
 }
 
 func (a *App) GracefulShutdown(ctx context.Context) error {
 	a.Logger.Info("shutting down server")
+
+	// load balancers stop sending requests to not ready app during delay
+	a.health.ShutDown()
+	if d := a.Config.Health.ShutdownDelay; d > 0 {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+		}
+	}
+
+	// stream and websocket connections are never idle, they are closed before server shutdown
+	if a.ws != nil {
+		a.ws.Close()
+	}
+	if a.stream != nil {
+		if err := a.stream.Stop(ctx); err != nil {
+			a.Logger.Error("failed to stop news stream", "error", err)
+		}
+	}
+
 	err := a.Echo.Shutdown(ctx)
 	if err != nil {
 		a.Logger.Error("failed to shutdown server", "error", err)
 		return err
 	}
 
+	if a.metricsServer != nil {
+		if err := a.metricsServer.Shutdown(ctx); err != nil {
+			a.Logger.Error("failed to shutdown metrics server", "error", err)
+		}
+	}
+
+	for _, w := range a.workers {
+		if err := w.Stop(ctx); err != nil {
+			a.Logger.Error("failed to stop worker", "error", err)
+			return err
+		}
+	}
+
+	// spans of stopped workers are exported too
+	if a.tracerProvider != nil {
+		if err := a.tracerProvider.Shutdown(ctx); err != nil {
+			a.Logger.Error("failed to shutdown tracer provider", "error", err)
+		}
+	}
+
 	a.Logger.Info("server shutdown complete")
 	return nil
 }
 
-func (a *App) setupRoutes(rpcServer *zenrpc.Server) {
+// setupRoutes registers enabled API surfaces with common middleware, health checks and frontend routes.
+// Nil rpcServer or restHandler means that surface is disabled.
+func (a *App) setupRoutes(rpcServer *zenrpc.Server, restHandler *rest.NewsHandler, authManager *newsportal.AuthManager) {
 	e := echo.New()
 
-	e.Any("/rpc", echo.WrapHandler(rpcServer))
+	e.Use(rest.RequestLogger(a.Logger))
+	e.Use(echomw.Recover())
+
+	if a.tracerProvider != nil {
+		e.Use(TracingMiddleware())
+	}
+	if a.metrics != nil {
+		e.Use(a.metrics.Middleware())
+		if a.Config.Metrics.Addr == "" {
+			e.GET(metricsPath, echo.WrapHandler(a.metrics.Handler()))
+		} else {
+			mux := http.NewServeMux()
+			mux.Handle(metricsPath, a.metrics.Handler())
+			a.metricsServer = &http.Server{Addr: a.Config.Metrics.Addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		}
+	}
+
+	e.GET("/healthz", a.health.Healthz)
+	e.GET("/readyz", a.health.Readyz)
+
+	if rpcServer != nil {
+		e.Any("/rpc", echo.WrapHandler(rpcServer), rest.BodyLimit(rpc.MaxRequestSize), rest.HTTPCache(a.Config.HTTPCache, rpc.IsIdempotent))
+		e.GET("/rpc/ws", echo.WrapHandler(a.ws))
 
-	e.Any("/doc/*", func(c echo.Context) error {
-		zenrpc.SMDBoxHandler(c.Response().Writer, c.Request())
-		return nil
-	})
+		e.Any("/doc/*", func(c echo.Context) error {
+			zenrpc.SMDBoxHandler(c.Response().Writer, c.Request())
+			return nil
+		}, rest.Authenticate(authManager), rest.RequireRole(authManager, a.Config.Auth.ReadRole))
+	}
+
+	if restHandler != nil {
+		restHandler.Register(e)
+	}
 
 	e.Static("/static", "./frontend")
 
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultReadyTimeout = 2 * time.Second
+
+	statusOK           = "ok"
+	statusFailed       = "failed"
+	statusShuttingDown = "shutting down"
+)
+
+// HealthConfig configures readiness checks and draining on shutdown.
+type HealthConfig struct {
+	// Timeout is a timeout of all readiness checks, default is 2 seconds.
+	Timeout time.Duration
+	// ShutdownDelay is a delay between reporting not ready and server shutdown, so load balancers stop
+	// sending new requests. It must be less than shutdown timeout of 5 seconds.
+	ShutdownDelay time.Duration
+}
+
+// Readiness is a response of /readyz.
+type Readiness struct {
+	Status string                 `json:"status"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
+
+// HealthCheck is a result of readiness check.
+type HealthCheck struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+	// Version is a Postgres version or applied migration version.
+	Version string `json:"version,omitempty"`
+	// Expected is a migration version expected by app.
+	Expected string `json:"expected,omitempty"`
+}
+
+// Health serves liveness and readiness endpoints.
+type Health struct {
+	db            db.DB
+	schemaVersion int64
+	timeout       time.Duration
+	shuttingDown  atomic.Bool
+}
+
+// NewHealth returns health checks of database, schemaVersion is a version of the latest migration expected by app.
+func NewHealth(database db.DB, schemaVersion int64, cfg HealthConfig) *Health {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultReadyTimeout
+	}
+
+	return &Health{db: database, schemaVersion: schemaVersion, timeout: cfg.Timeout}
+}
+
+// ShutDown makes app not ready.
+func (h *Health) ShutDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Healthz handles GET /healthz, it reports that process is alive.
+func (h *Health) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": statusOK})
+}
+
+// Readyz handles GET /readyz. App is ready when database answers within timeout and migrations
+// are applied up to the latest embedded migration. During graceful shutdown app is not ready.
+func (h *Health) Readyz(c echo.Context) error {
+	if h.shuttingDown.Load() {
+		return c.JSON(http.StatusServiceUnavailable, Readiness{Status: statusShuttingDown})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.timeout)
+	defer cancel()
+
+	r := h.check(ctx)
+	if r.Status != statusOK {
+		return c.JSON(http.StatusServiceUnavailable, r)
+	}
+
+	return c.JSON(http.StatusOK, r)
+}
+
+// check runs database and migrations checks, migrations are not checked without database.
+func (h *Health) check(ctx context.Context) Readiness {
+	r := Readiness{Status: statusOK, Checks: map[string]HealthCheck{}}
+
+	database := h.checkDatabase(ctx)
+	r.Checks["database"] = database
+	if database.Status != statusOK {
+		r.Status = statusFailed
+		return r
+	}
+
+	migrations := h.checkMigrations(ctx)
+	r.Checks["migrations"] = migrations
+	if migrations.Status != statusOK {
+		r.Status = statusFailed
+	}
+
+	return r
+}
+
+func (h *Health) checkDatabase(ctx context.Context) HealthCheck {
+	start := time.Now()
+
+	if err := h.db.Ping(ctx); err != nil {
+		return newHealthCheck(start, err)
+	}
+
+	conn := db.New(h.db.WithContext(ctx))
+	version, err := conn.Version()
+	hc := newHealthCheck(start, err)
+	if err == nil {
+		hc.Version = version
+	}
+
+	return hc
+}
+
+// checkMigrations fails when applied migration is older than expected, newer migrations are allowed
+// during rolling updates.
+func (h *Health) checkMigrations(ctx context.Context) HealthCheck {
+	start := time.Now()
+
+	version, err := h.db.MigrationVersion(ctx)
+	hc := newHealthCheck(start, err)
+	if err != nil {
+		return hc
+	}
+
+	hc.Version, hc.Expected = strconv.FormatInt(version, 10), strconv.FormatInt(h.schemaVersion, 10)
+	if version < h.schemaVersion {
+		hc.Status, hc.Error = statusFailed, "migrations are not applied"
+	}
+
+	return hc
+}
+
+func newHealthCheck(start time.Time, err error) HealthCheck {
+	hc := HealthCheck{Status: statusOK, Duration: time.Since(start).String()}
+	if err != nil {
+		hc.Status, hc.Error = statusFailed, err.Error()
+	}
+
+	return hc
+}
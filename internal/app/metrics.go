@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/go-pg/pg/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "app"
+	metricsPath      = "/metrics"
+)
+
+// MetricsConfig configures Prometheus metrics endpoint.
+type MetricsConfig struct {
+	// Enabled turns on metrics collection and /metrics endpoint.
+	Enabled bool
+	// Addr is a listen address of separate metrics server, e.g. ":9100". Empty Addr serves /metrics on app port.
+	Addr string
+}
+
+// Metrics collects HTTP, database and cache metrics. RPC metrics are collected by zenrpc middleware
+// in default registry, handler exposes both registries.
+type Metrics struct {
+	registry      *prometheus.Registry
+	httpDurations *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDurations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request duration by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "Database query duration by operation and result.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"operation", "error"}),
+	}
+
+	m.registry.MustRegister(m.httpDurations, m.queryDuration)
+
+	return m
+}
+
+// Handler returns handler of metrics in Prometheus format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(prometheus.Gatherers{prometheus.DefaultGatherer, m.registry}, promhttp.HandlerOpts{})
+}
+
+// Middleware returns echo middleware that observes request duration by route path.
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					status = he.Code
+				} else if !c.Response().Committed {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			m.httpDurations.WithLabelValues(c.Request().Method, c.Path(), strconv.Itoa(status)).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// RegisterDB adds query duration hook and connection pool stats of database.
+func (m *Metrics) RegisterDB(db *pg.DB) {
+	db.AddQueryHook(queryHook{duration: m.queryDuration})
+
+	stats := func(fn func(*pg.PoolStats) uint32) func() float64 {
+		return func() float64 { return float64(fn(db.PoolStats())) }
+	}
+
+	m.registry.MustRegister(
+		m.counterFunc("db_pool", "hits_total", "Number of times free connection was found in the pool.", stats(func(s *pg.PoolStats) uint32 { return s.Hits })),
+		m.counterFunc("db_pool", "misses_total", "Number of times free connection was not found in the pool.", stats(func(s *pg.PoolStats) uint32 { return s.Misses })),
+		m.counterFunc("db_pool", "timeouts_total", "Number of pool wait timeouts.", stats(func(s *pg.PoolStats) uint32 { return s.Timeouts })),
+		m.counterFunc("db_pool", "stale_connections_total", "Number of stale connections removed from the pool.", stats(func(s *pg.PoolStats) uint32 { return s.StaleConns })),
+		m.gaugeFunc("db_pool", "connections", "Number of connections in the pool.", stats(func(s *pg.PoolStats) uint32 { return s.TotalConns })),
+		m.gaugeFunc("db_pool", "idle_connections", "Number of idle connections in the pool.", stats(func(s *pg.PoolStats) uint32 { return s.IdleConns })),
+	)
+}
+
+// RegisterCache adds hits, misses, size and hit ratio of manager cache.
+func (m *Metrics) RegisterCache(manager *newsportal.Manager) {
+	m.registry.MustRegister(
+		m.counterFunc("cache", "hits_total", "Number of cache hits.", func() float64 { return float64(manager.CacheStats().Hits) }),
+		m.counterFunc("cache", "misses_total", "Number of cache misses.", func() float64 { return float64(manager.CacheStats().Misses) }),
+		m.gaugeFunc("cache", "entries", "Number of cached entries.", func() float64 { return float64(manager.CacheStats().Size) }),
+		m.gaugeFunc("cache", "hit_ratio", "Cache hits to lookups ratio.", func() float64 { return manager.CacheStats().HitRatio() }),
+	)
+}
+
+func (m *Metrics) counterFunc(subsystem, name, help string, fn func() float64) prometheus.Collector {
+	return prometheus.NewCounterFunc(prometheus.CounterOpts{Namespace: metricsNamespace, Subsystem: subsystem, Name: name, Help: help}, fn)
+}
+
+func (m *Metrics) gaugeFunc(subsystem, name, help string, fn func() float64) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Namespace: metricsNamespace, Subsystem: subsystem, Name: name, Help: help}, fn)
+}
+
+// queryHook observes duration of go-pg queries.
+type queryHook struct {
+	duration *prometheus.HistogramVec
+}
+
+func (h queryHook) BeforeQuery(ctx context.Context, _ *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h queryHook) AfterQuery(_ context.Context, event *pg.QueryEvent) error {
+	h.duration.WithLabelValues(queryOperation(event), strconv.FormatBool(event.Err != nil)).Observe(time.Since(event.StartTime).Seconds())
+	return nil
+}
+
+// queryOperation returns the first keyword of query in upper case, e.g. SELECT.
+func queryOperation(event *pg.QueryEvent) string {
+	query, err := event.UnformattedQuery()
+	if err != nil {
+		return "unknown"
+	}
+
+	op, _, _ := strings.Cut(strings.TrimSpace(string(query)), " ")
+	switch op = strings.ToUpper(op); op {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "WITH", "BEGIN", "COMMIT", "ROLLBACK", "LISTEN", "UNLISTEN":
+		return op
+	}
+
+	return "other"
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
+
+const defaultSchedulerInterval = time.Minute
+
+// SchedulerConfig configures publishing of scheduled news.
+type SchedulerConfig struct {
+	// Enabled turns on scheduler.
+	Enabled bool
+	// Interval is a period of checking scheduled news, default is 1 minute.
+	Interval time.Duration
+}
+
+// NewScheduler returns worker that publishes scheduled news with publishedAt in the past.
+func NewScheduler(database db.DB, manager *newsportal.Manager, logger *slog.Logger, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+
+	return NewWorker("scheduler", database, logger, interval, func(ctx context.Context) error {
+		published, err := manager.PublishScheduledNews(ctx)
+		for _, n := range published {
+			logger.Info("scheduled news published", "newsId", n.ID, "publishedAt", n.PublishedAt)
+		}
+
+		return err
+	})
+}
@@ -0,0 +1,217 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/labstack/echo/v4"
+	"github.com/vmkteam/zenrpc/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName         = "github.com/daniilsolovey/news-portal"
+	defaultServiceName = "news-portal"
+
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// TracingConfig configures OpenTelemetry tracing of HTTP requests, RPC methods and database queries.
+type TracingConfig struct {
+	// Enabled turns on tracing.
+	Enabled bool
+	// Exporter is "otlp" for OTLP over HTTP or "stdout" for local debugging, default is otlp.
+	Exporter string
+	// Endpoint is OTLP collector host:port, default is OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4318.
+	Endpoint string
+	// Insecure disables TLS of OTLP exporter.
+	Insecure bool
+	// SampleRatio is a ratio of sampled root spans from 0 to 1, default is 1.
+	// Spans of requests with sampled parent are always sampled.
+	SampleRatio float64
+	// ServiceName is a service.name resource attribute, default is news-portal.
+	ServiceName string
+}
+
+// NewTracerProvider returns tracer provider with configured exporter and sets it as global provider
+// with W3C trace context and baggage propagation.
+func NewTracerProvider(ctx context.Context, cfg TracingConfig) (*sdktrace.TracerProvider, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch cfg.Exporter {
+	case "", ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create trace exporter: %w", err)
+	}
+
+	serviceName, ratio := cfg.ServiceName, cfg.SampleRatio
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp, nil
+}
+
+// TracingMiddleware returns echo middleware that starts server span of request with parent from traceparent header.
+func TracingMiddleware() echo.MiddlewareFunc {
+	tracer := otel.Tracer(tracerName)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			route := c.Path()
+			ctx, span := tracer.Start(ctx, r.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(r.URL.Path),
+					semconv.ClientAddress(c.RealIP()),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(r.WithContext(ctx))
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					status = he.Code
+				} else if !c.Response().Committed {
+					status = http.StatusInternalServerError
+				}
+				span.RecordError(err)
+			}
+
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+
+			return err
+		}
+	}
+}
+
+// RPCTracing returns zenrpc middleware that starts span of method call, e.g. "news.List".
+func RPCTracing() zenrpc.MiddlewareFunc {
+	tracer := otel.Tracer(tracerName)
+
+	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
+		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+			namespace := zenrpc.NamespaceFromContext(ctx)
+
+			ctx, span := tracer.Start(ctx, namespace+"."+method, trace.WithAttributes(
+				semconv.RPCSystemKey.String("jsonrpc"),
+				semconv.RPCService(namespace),
+				semconv.RPCMethod(method),
+			))
+			defer span.End()
+
+			r := h(ctx, method, params)
+			if r.Error != nil {
+				span.SetAttributes(semconv.RPCJSONRPCErrorCode(r.Error.Code), semconv.RPCJSONRPCErrorMessage(r.Error.Message))
+				span.SetStatus(codes.Error, r.Error.Message)
+			}
+
+			return r
+		}
+	}
+}
+
+// tracingHook starts client span of every go-pg query.
+type tracingHook struct {
+	tracer trace.Tracer
+}
+
+// NewTracingHook returns go-pg query hook that traces queries with unformatted statement, query params are not recorded.
+func NewTracingHook() pg.QueryHook {
+	return tracingHook{tracer: otel.Tracer(tracerName)}
+}
+
+func (h tracingHook) BeforeQuery(ctx context.Context, event *pg.QueryEvent) (context.Context, error) {
+	operation, table := queryOperation(event), queryTable(event)
+
+	attrs := []attribute.KeyValue{semconv.DBSystemNamePostgreSQL, semconv.DBOperationName(operation)}
+	if table != "" {
+		attrs = append(attrs, semconv.DBCollectionName(table))
+	}
+	if query, err := event.UnformattedQuery(); err == nil {
+		attrs = append(attrs, semconv.DBQueryText(string(query)))
+	}
+
+	name := strings.TrimSpace(operation + " " + table)
+	ctx, _ = h.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	return ctx, nil
+}
+
+func (h tracingHook) AfterQuery(ctx context.Context, event *pg.QueryEvent) error {
+	span := trace.SpanFromContext(ctx)
+	if event.Err != nil && !errors.Is(event.Err, pg.ErrNoRows) {
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	}
+	span.End()
+
+	return nil
+}
+
+// queryTable returns table name of ORM query or empty string for raw queries.
+func queryTable(event *pg.QueryEvent) string {
+	q, ok := event.Query.(*orm.Query)
+	if !ok || q.TableModel() == nil {
+		return ""
+	}
+
+	return strings.Trim(string(q.TableModel().Table().SQLName), `"`)
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
+
+// NewWebhookWorker returns worker that sends pending webhook deliveries.
+func NewWebhookWorker(database db.DB, manager *newsportal.WebhookManager, logger *slog.Logger) *Worker {
+	return NewWorker("webhooks", database, logger, manager.Config().Interval, func(ctx context.Context) error {
+		sent, err := manager.DeliverWebhooks(ctx)
+		if sent > 0 {
+			logger.Debug("webhook deliveries sent", "count", sent)
+		}
+
+		return err
+	})
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+)
+
+// Worker periodically runs job under advisory lock, so only one app instance runs the job per tick.
+type Worker struct {
+	name     string
+	db       db.DB
+	logger   *slog.Logger
+	interval time.Duration
+	job      func(ctx context.Context) error
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWorker(name string, database db.DB, logger *slog.Logger, interval time.Duration, job func(ctx context.Context) error) *Worker {
+	return &Worker{
+		name:     name,
+		db:       database,
+		logger:   logger.With("worker", name),
+		interval: interval,
+		job:      job,
+	}
+}
+
+// Start runs worker in background until Stop is called or ctx is done.
+func (w *Worker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	go w.run(ctx)
+	w.logger.Info("worker started", "interval", w.interval)
+}
+
+// Stop stops worker and waits for the current run to finish until ctx is done.
+func (w *Worker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	select {
+	case <-w.done:
+		w.logger.Info("worker stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Worker) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runJob(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runJob runs job under session advisory lock. Job makes changes in its own transactions, lock only
+// prevents concurrent runs. Run is skipped if lock is held by another app instance.
+func (w *Worker) runJob(ctx context.Context) {
+	locked, err := w.db.TryRunWithLock(ctx, "newsportal:"+w.name, w.job)
+
+	switch {
+	case err != nil && ctx.Err() == nil:
+		w.logger.Error("worker run failed", "error", err)
+	case err == nil && !locked:
+		w.logger.Debug("worker run skipped, lock is held by another instance")
+	}
+}
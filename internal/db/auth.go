@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+type AuthRepo struct {
+	db      orm.DB
+	filters map[string][]Filter
+	sort    map[string][]SortField
+	join    map[string][]string
+}
+
+// NewAuthRepo returns new repository
+func NewAuthRepo(db orm.DB) AuthRepo {
+	return AuthRepo{
+		db: db,
+		filters: map[string][]Filter{
+			Tables.APIKey.Name: {StatusFilter},
+		},
+		sort: map[string][]SortField{
+			Tables.APIKey.Name: {{Column: Columns.APIKey.CreatedAt, Direction: SortDesc}},
+		},
+		join: map[string][]string{
+			Tables.APIKey.Name: {TableColumns},
+		},
+	}
+}
+
+// WithTransaction is a function that wraps AuthRepo with pg.Tx transaction.
+func (ar AuthRepo) WithTransaction(tx *pg.Tx) AuthRepo {
+	ar.db = tx
+	return ar
+}
+
+// WithEnabledOnly is a function that adds "statusId"=1 as base filter.
+func (ar AuthRepo) WithEnabledOnly() AuthRepo {
+	f := make(map[string][]Filter, len(ar.filters))
+	for i := range ar.filters {
+		f[i] = make([]Filter, len(ar.filters[i]))
+		copy(f[i], ar.filters[i])
+		f[i] = append(f[i], StatusEnabledFilter)
+	}
+	ar.filters = f
+
+	return ar
+}
+
+/*** APIKey ***/
+
+// FullAPIKey returns full joins with all columns
+func (ar AuthRepo) FullAPIKey() OpFunc {
+	return WithColumns(ar.join[Tables.APIKey.Name]...)
+}
+
+// DefaultAPIKeySort returns default sort.
+func (ar AuthRepo) DefaultAPIKeySort() OpFunc {
+	return WithSort(ar.sort[Tables.APIKey.Name]...)
+}
+
+// APIKeyByID is a function that returns APIKey by ID(s) or nil.
+func (ar AuthRepo) APIKeyByID(ctx context.Context, id int, ops ...OpFunc) (*APIKey, error) {
+	return ar.OneAPIKey(ctx, &APIKeySearch{ID: &id}, ops...)
+}
+
+// OneAPIKey is a function that returns one APIKey by filters. It could return pg.ErrMultiRows.
+func (ar AuthRepo) OneAPIKey(ctx context.Context, search *APIKeySearch, ops ...OpFunc) (*APIKey, error) {
+	obj := &APIKey{}
+	err := buildQuery(ctx, ar.db, obj, search, ar.filters[Tables.APIKey.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// APIKeysByFilters returns APIKey list.
+func (ar AuthRepo) APIKeysByFilters(ctx context.Context, search *APIKeySearch, pager Pager, ops ...OpFunc) (apiKeys []APIKey, err error) {
+	err = buildQuery(ctx, ar.db, &apiKeys, search, ar.filters[Tables.APIKey.Name], pager, ops...).Select()
+	return
+}
+
+// CountAPIKeys returns count
+func (ar AuthRepo) CountAPIKeys(ctx context.Context, search *APIKeySearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, ar.db, &APIKey{}, search, ar.filters[Tables.APIKey.Name], PagerOne, ops...).Count()
+}
+
+// AddAPIKey adds APIKey to DB.
+func (ar AuthRepo) AddAPIKey(ctx context.Context, apiKey *APIKey, ops ...OpFunc) (*APIKey, error) {
+	q := ar.db.ModelContext(ctx, apiKey)
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return apiKey, err
+}
+
+// UpdateAPIKey updates APIKey in DB.
+func (ar AuthRepo) UpdateAPIKey(ctx context.Context, apiKey *APIKey, ops ...OpFunc) (bool, error) {
+	q := ar.db.ModelContext(ctx, apiKey).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.APIKey.ID)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteAPIKey set statusId to deleted in DB.
+func (ar AuthRepo) DeleteAPIKey(ctx context.Context, id int) (deleted bool, err error) {
+	apiKey := &APIKey{ID: id, StatusID: StatusDeleted}
+
+	return ar.UpdateAPIKey(ctx, apiKey, WithColumns(Columns.APIKey.StatusID))
+}
@@ -4,6 +4,8 @@ package db
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"hash/crc64"
 	"reflect"
 
@@ -34,6 +36,20 @@ func (db *DB) Version() (string, error) {
 	return v, nil
 }
 
+// MigrationVersion is a function that returns version of the latest applied goose migration.
+func (db *DB) MigrationVersion(ctx context.Context) (int64, error) {
+	var v int64
+	_, err := db.QueryOneContext(ctx, pg.Scan(&v), `
+		SELECT coalesce(max("version_id"), 0) FROM (
+			SELECT DISTINCT ON ("version_id") "version_id", "is_applied" FROM "goose_db_version" ORDER BY "version_id", "id" DESC
+		) v WHERE "is_applied"`)
+	if err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
 // runInTransaction runs chain of functions in transaction until first error
 func (db *DB) runInTransaction(ctx context.Context, fns ...func(*pg.Tx) error) error {
 	return db.RunInTransaction(ctx, func(tx *pg.Tx) error {
@@ -65,6 +81,29 @@ func (db *DB) RunInLock(ctx context.Context, lockName string, fns ...func(*pg.Tx
 	})
 }
 
+// TryRunWithLock runs fn with session advisory lock if lock is free. Lock is held on dedicated connection
+// and fn runs outside of transaction, so long-running fn doesn't keep transaction open.
+// It doesn't wait for lock and returns false if lock is held by another session.
+func (db *DB) TryRunWithLock(ctx context.Context, lockName string, fn func(context.Context) error) (locked bool, err error) {
+	lock := int64(crc64.Checksum([]byte(lockName), db.crcTable))
+
+	conn := db.Conn()
+	defer conn.Close()
+
+	if _, err = conn.QueryOneContext(ctx, pg.Scan(&locked), "select pg_try_advisory_lock(?) -- ?", lock, lockName); err != nil || !locked {
+		return locked, err
+	}
+
+	defer func() {
+		// lock is released even if ctx is canceled, otherwise connection returns to pool with lock
+		if _, uErr := conn.ExecContext(context.WithoutCancel(ctx), "select pg_advisory_unlock(?) -- ?", lock, lockName); uErr != nil {
+			err = errors.Join(err, fmt.Errorf("release lock %s: %w", lockName, uErr))
+		}
+	}()
+
+	return true, fn(ctx)
+}
+
 // buildQuery applies all functions to orm query.
 func buildQuery(ctx context.Context, db orm.DB, model interface{}, search Searcher, filters []Filter, pager Pager, ops ...OpFunc) *orm.Query {
 	q := db.ModelContext(ctx, model)
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+const (
+	// text search configurations supported by news search vector
+	TextSearchRussian = "russian"
+	TextSearchEnglish = "english"
+
+	// newsSearchVector is a generated tsvector column over news title and content.
+	newsSearchVector = "searchVector"
+)
+
+// IsTextSearchConfig checks that config is supported by news search vector.
+func IsTextSearchConfig(config string) bool {
+	return config == TextSearchRussian || config == TextSearchEnglish
+}
+
+// WithTextSearch filters news by full-text query and sorts them by ts_rank desc.
+// Query uses websearch syntax: quoted phrases, "or" and "-" are supported.
+func WithTextSearch(config, query string) OpFunc {
+	return func(q *orm.Query) {
+		tsQuery := pg.SafeQuery("websearch_to_tsquery(?::regconfig, ?)", config, query)
+		q.Where("?.? @@ ?", pg.Ident(Tables.News.Alias), pg.Ident(newsSearchVector), tsQuery)
+		q.OrderExpr("ts_rank(?.?, ?) DESC", pg.Ident(Tables.News.Alias), pg.Ident(newsSearchVector), tsQuery)
+	}
+}
+
+// HeadlineOptions are ts_headline options for search snippets.
+type HeadlineOptions struct {
+	StartSel string
+	StopSel  string
+	MaxWords int
+	MinWords int
+}
+
+// String returns options in ts_headline format. Quotes and commas are removed from selectors.
+func (o HeadlineOptions) String() string {
+	clean := strings.NewReplacer(`"`, "", ",", "")
+	return fmt.Sprintf(`StartSel="%s", StopSel="%s", MaxWords=%d, MinWords=%d`,
+		clean.Replace(o.StartSel), clean.Replace(o.StopSel), o.MaxWords, o.MinWords)
+}
+
+// NewsHeadlines returns ts_headline snippets of news content for full-text query, indexed by news ID.
+func (nr NewsRepo) NewsHeadlines(ctx context.Context, config, query string, ids []int, opts HeadlineOptions) (map[int]string, error) {
+	if len(ids) == 0 {
+		return map[int]string{}, nil
+	}
+
+	var rows []struct {
+		ID       int    `pg:"newsId"`
+		Headline string `pg:"headline"`
+	}
+
+	_, err := nr.db.QueryContext(ctx, &rows, `
+		SELECT ?, ts_headline(?::regconfig, coalesce(?, ''), websearch_to_tsquery(?::regconfig, ?), ?) AS "headline"
+		FROM ? WHERE ? IN (?)`,
+		pg.Ident(Columns.News.ID), config, pg.Ident(Columns.News.Content), config, query, opts.String(),
+		pg.Ident(Tables.News.Name), pg.Ident(Columns.News.ID), pg.In(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[int]string, len(rows))
+	for _, row := range rows {
+		r[row.ID] = row.Headline
+	}
+
+	return r, nil
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/lib/pq"
+	"github.com/pressly/goose/v3"
+)
+
+// migrationsLock is a name of advisory lock of applied migrations.
+const migrationsLock = "newsportal:migrate"
+
+// Migrator applies goose migrations under advisory lock, so concurrently started instances apply them once.
+type Migrator struct {
+	db       DB
+	provider *goose.Provider
+}
+
+// NewMigrator returns migrator of migrations in root of fsys. Migrations are applied on separate connection
+// opened with dsn of db, it is closed by Close. Lock is taken on db.
+func NewMigrator(db DB, dsn string, fsys fs.FS) (*Migrator, error) {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse database dsn: %w", err)
+	}
+
+	sqldb := sql.OpenDB(connector)
+	provider, err := goose.NewProvider(goose.DialectPostgres, sqldb, fsys)
+	if err != nil {
+		_ = sqldb.Close()
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	return &Migrator{db: db, provider: provider}, nil
+}
+
+// Up applies all pending migrations, results contain applied migrations even on error.
+func (m *Migrator) Up(ctx context.Context) (results []*goose.MigrationResult, err error) {
+	err = m.db.RunInLock(ctx, migrationsLock, func(*pg.Tx) error {
+		results, err = m.provider.Up(ctx)
+		return err
+	})
+
+	return results, err
+}
+
+// Down rolls back the latest applied migration.
+func (m *Migrator) Down(ctx context.Context) (result *goose.MigrationResult, err error) {
+	err = m.db.RunInLock(ctx, migrationsLock, func(*pg.Tx) error {
+		result, err = m.provider.Down(ctx)
+		return err
+	})
+
+	return result, err
+}
+
+// Status returns all migrations with their state ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Close closes connection of migrations.
+func (m *Migrator) Close() error {
+	return m.provider.Close()
+}
+
+// LatestMigration returns version of the latest migration in root of fsys.
+func LatestMigration(fsys fs.FS) (int64, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	for _, f := range files {
+		v, err := goose.NumericComponent(f)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: %w", f, err)
+		}
+		version = max(version, v)
+	}
+	if version == 0 {
+		return 0, errors.New("no migrations found")
+	}
+
+	return version, nil
+}
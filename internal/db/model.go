@@ -9,6 +9,12 @@ import (
 )
 
 var Columns = struct {
+	APIKey struct {
+		ID, Title, KeyHash, Role, CreatedAt, ExpiresAt, StatusID string
+	}
+	AuditLog struct {
+		ID, Actor, Entity, EntityID, Action, Diff, CreatedAt string
+	}
 	Category struct {
 		ID, Title, OrderNumber, StatusID string
 	}
@@ -17,10 +23,43 @@ var Columns = struct {
 
 		Category string
 	}
+	NewsRevision struct {
+		ID, NewsID, CategoryID, Title, Content, TagIDs, Actor, CreatedAt string
+	}
 	Tag struct {
 		ID, Title, StatusID string
 	}
+	Webhook struct {
+		ID, URL, Secret, EventTypes, CreatedAt, StatusID string
+	}
+	WebhookDelivery struct {
+		ID, WebhookID, EventType, Payload, State, Attempts, NextAttemptAt, LastError, CreatedAt, DeliveredAt string
+
+		Webhook string
+	}
 }{
+	APIKey: struct {
+		ID, Title, KeyHash, Role, CreatedAt, ExpiresAt, StatusID string
+	}{
+		ID:        "apiKeyId",
+		Title:     "title",
+		KeyHash:   "keyHash",
+		Role:      "role",
+		CreatedAt: "createdAt",
+		ExpiresAt: "expiresAt",
+		StatusID:  "statusId",
+	},
+	AuditLog: struct {
+		ID, Actor, Entity, EntityID, Action, Diff, CreatedAt string
+	}{
+		ID:        "auditLogId",
+		Actor:     "actor",
+		Entity:    "entity",
+		EntityID:  "entityId",
+		Action:    "action",
+		Diff:      "diff",
+		CreatedAt: "createdAt",
+	},
 	Category: struct {
 		ID, Title, OrderNumber, StatusID string
 	}{
@@ -46,6 +85,18 @@ var Columns = struct {
 
 		Category: "Category",
 	},
+	NewsRevision: struct {
+		ID, NewsID, CategoryID, Title, Content, TagIDs, Actor, CreatedAt string
+	}{
+		ID:         "newsRevisionId",
+		NewsID:     "newsId",
+		CategoryID: "categoryId",
+		Title:      "title",
+		Content:    "content",
+		TagIDs:     "tagIds",
+		Actor:      "actor",
+		CreatedAt:  "createdAt",
+	},
 	Tag: struct {
 		ID, Title, StatusID string
 	}{
@@ -53,19 +104,74 @@ var Columns = struct {
 		Title:    "title",
 		StatusID: "statusId",
 	},
+	Webhook: struct {
+		ID, URL, Secret, EventTypes, CreatedAt, StatusID string
+	}{
+		ID:         "webhookId",
+		URL:        "url",
+		Secret:     "secret",
+		EventTypes: "eventTypes",
+		CreatedAt:  "createdAt",
+		StatusID:   "statusId",
+	},
+	WebhookDelivery: struct {
+		ID, WebhookID, EventType, Payload, State, Attempts, NextAttemptAt, LastError, CreatedAt, DeliveredAt string
+
+		Webhook string
+	}{
+		ID:            "webhookDeliveryId",
+		WebhookID:     "webhookId",
+		EventType:     "eventType",
+		Payload:       "payload",
+		State:         "state",
+		Attempts:      "attempts",
+		NextAttemptAt: "nextAttemptAt",
+		LastError:     "lastError",
+		CreatedAt:     "createdAt",
+		DeliveredAt:   "deliveredAt",
+
+		Webhook: "Webhook",
+	},
 }
 
 var Tables = struct {
+	APIKey struct {
+		Name, Alias string
+	}
+	AuditLog struct {
+		Name, Alias string
+	}
 	Category struct {
 		Name, Alias string
 	}
 	News struct {
 		Name, Alias string
 	}
+	NewsRevision struct {
+		Name, Alias string
+	}
 	Tag struct {
 		Name, Alias string
 	}
+	Webhook struct {
+		Name, Alias string
+	}
+	WebhookDelivery struct {
+		Name, Alias string
+	}
 }{
+	APIKey: struct {
+		Name, Alias string
+	}{
+		Name:  "apiKeys",
+		Alias: "t",
+	},
+	AuditLog: struct {
+		Name, Alias string
+	}{
+		Name:  "auditLog",
+		Alias: "t",
+	},
 	Category: struct {
 		Name, Alias string
 	}{
@@ -78,12 +184,54 @@ var Tables = struct {
 		Name:  "news",
 		Alias: "t",
 	},
+	NewsRevision: struct {
+		Name, Alias string
+	}{
+		Name:  "newsRevisions",
+		Alias: "t",
+	},
 	Tag: struct {
 		Name, Alias string
 	}{
 		Name:  "tags",
 		Alias: "t",
 	},
+	Webhook: struct {
+		Name, Alias string
+	}{
+		Name:  "webhooks",
+		Alias: "t",
+	},
+	WebhookDelivery: struct {
+		Name, Alias string
+	}{
+		Name:  "webhookDeliveries",
+		Alias: "t",
+	},
+}
+
+type APIKey struct {
+	tableName struct{} `pg:"apiKeys,alias:t,discard_unknown_columns"`
+
+	ID        int        `pg:"apiKeyId,pk"`
+	Title     string     `pg:"title,use_zero"`
+	KeyHash   string     `pg:"keyHash,use_zero"`
+	Role      string     `pg:"role,use_zero"`
+	CreatedAt time.Time  `pg:"createdAt,use_zero"`
+	ExpiresAt *time.Time `pg:"expiresAt"`
+	StatusID  int        `pg:"statusId,use_zero"`
+}
+
+type AuditLog struct {
+	tableName struct{} `pg:"auditLog,alias:t,discard_unknown_columns"`
+
+	ID        int                    `pg:"auditLogId,pk"`
+	Actor     string                 `pg:"actor,use_zero"`
+	Entity    string                 `pg:"entity,use_zero"`
+	EntityID  int                    `pg:"entityId,use_zero"`
+	Action    string                 `pg:"action,use_zero"`
+	Diff      map[string]interface{} `pg:"diff,use_zero"`
+	CreatedAt time.Time              `pg:"createdAt,use_zero"`
 }
 
 type Category struct {
@@ -111,6 +259,19 @@ type News struct {
 	Category *Category `pg:"fk:categoryId,rel:has-one"`
 }
 
+type NewsRevision struct {
+	tableName struct{} `pg:"newsRevisions,alias:t,discard_unknown_columns"`
+
+	ID         int       `pg:"newsRevisionId,pk"`
+	NewsID     int       `pg:"newsId,use_zero"`
+	CategoryID int       `pg:"categoryId,use_zero"`
+	Title      string    `pg:"title,use_zero"`
+	Content    *string   `pg:"content"`
+	TagIDs     []int     `pg:"tagIds,array,use_zero"`
+	Actor      string    `pg:"actor,use_zero"`
+	CreatedAt  time.Time `pg:"createdAt,use_zero"`
+}
+
 type Tag struct {
 	tableName struct{} `pg:"tags,alias:t,discard_unknown_columns"`
 
@@ -118,3 +279,31 @@ type Tag struct {
 	Title    string `pg:"title,use_zero"`
 	StatusID int    `pg:"statusId,use_zero"`
 }
+
+type Webhook struct {
+	tableName struct{} `pg:"webhooks,alias:t,discard_unknown_columns"`
+
+	ID         int       `pg:"webhookId,pk"`
+	URL        string    `pg:"url,use_zero"`
+	Secret     string    `pg:"secret,use_zero"`
+	EventTypes []string  `pg:"eventTypes,array,use_zero"`
+	CreatedAt  time.Time `pg:"createdAt,use_zero"`
+	StatusID   int       `pg:"statusId,use_zero"`
+}
+
+type WebhookDelivery struct {
+	tableName struct{} `pg:"webhookDeliveries,alias:t,discard_unknown_columns"`
+
+	ID            int                    `pg:"webhookDeliveryId,pk"`
+	WebhookID     int                    `pg:"webhookId,use_zero"`
+	EventType     string                 `pg:"eventType,use_zero"`
+	Payload       map[string]interface{} `pg:"payload,use_zero"`
+	State         string                 `pg:"state,use_zero"`
+	Attempts      int                    `pg:"attempts,use_zero"`
+	NextAttemptAt time.Time              `pg:"nextAttemptAt,use_zero"`
+	LastError     *string                `pg:"lastError"`
+	CreatedAt     time.Time              `pg:"createdAt,use_zero"`
+	DeliveredAt   *time.Time             `pg:"deliveredAt"`
+
+	Webhook *Webhook `pg:"fk:webhookId,rel:has-one"`
+}
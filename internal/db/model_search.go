@@ -52,6 +52,126 @@ type Searcher interface {
 	WithApply(a applier)
 }
 
+type APIKeySearch struct {
+	search
+
+	ID         *int
+	Title      *string
+	KeyHash    *string
+	Role       *string
+	CreatedAt  *time.Time
+	ExpiresAt  *time.Time
+	StatusID   *int
+	IDs        []int
+	TitleILike *string
+}
+
+func (aks *APIKeySearch) Apply(query *orm.Query) *orm.Query {
+	if aks == nil {
+		return query
+	}
+	if aks.ID != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.ID, aks.ID)
+	}
+	if aks.Title != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.Title, aks.Title)
+	}
+	if aks.KeyHash != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.KeyHash, aks.KeyHash)
+	}
+	if aks.Role != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.Role, aks.Role)
+	}
+	if aks.CreatedAt != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.CreatedAt, aks.CreatedAt)
+	}
+	if aks.ExpiresAt != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.ExpiresAt, aks.ExpiresAt)
+	}
+	if aks.StatusID != nil {
+		aks.where(query, Tables.APIKey.Alias, Columns.APIKey.StatusID, aks.StatusID)
+	}
+	if len(aks.IDs) > 0 {
+		Filter{Columns.APIKey.ID, aks.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if aks.TitleILike != nil {
+		Filter{Columns.APIKey.Title, *aks.TitleILike, SearchTypeILike, false}.Apply(query)
+	}
+
+	aks.apply(query)
+
+	return query
+}
+
+func (aks *APIKeySearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if aks == nil {
+			return query, nil
+		}
+		return aks.Apply(query), nil
+	}
+}
+
+type AuditLogSearch struct {
+	search
+
+	ID          *int
+	Actor       *string
+	Entity      *string
+	EntityID    *int
+	Action      *string
+	CreatedAt   *time.Time
+	IDs         []int
+	CreatedAtGE *time.Time
+	CreatedAtLT *time.Time
+}
+
+func (als *AuditLogSearch) Apply(query *orm.Query) *orm.Query {
+	if als == nil {
+		return query
+	}
+	if als.ID != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.ID, als.ID)
+	}
+	if als.Actor != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Actor, als.Actor)
+	}
+	if als.Entity != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Entity, als.Entity)
+	}
+	if als.EntityID != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.EntityID, als.EntityID)
+	}
+	if als.Action != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Action, als.Action)
+	}
+	if als.CreatedAt != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.CreatedAt, als.CreatedAt)
+	}
+	if len(als.IDs) > 0 {
+		Filter{Columns.AuditLog.ID, als.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if als.CreatedAtGE != nil {
+		Filter{Columns.AuditLog.CreatedAt, *als.CreatedAtGE, SearchTypeGE, false}.Apply(query)
+	}
+	if als.CreatedAtLT != nil {
+		Filter{Columns.AuditLog.CreatedAt, *als.CreatedAtLT, SearchTypeLess, false}.Apply(query)
+	}
+
+	als.apply(query)
+
+	return query
+}
+
+func (als *AuditLogSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if als == nil {
+			return query, nil
+		}
+		return als.Apply(query), nil
+	}
+}
+
 type CategorySearch struct {
 	search
 
@@ -118,6 +238,10 @@ type NewsSearch struct {
 	CategoryStatus *int
 	Tag            *int
 	PublishedAtLE  *time.Time
+	PublishedAtGE  *time.Time
+	IDGT           *int
+	IDLE           *int
+	StatusIDs      []int
 }
 
 func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
@@ -169,6 +293,18 @@ func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
 	if ns.PublishedAtLE != nil {
 		Filter{Columns.News.PublishedAt, *ns.PublishedAtLE, SearchTypeLE, false}.Apply(query)
 	}
+	if ns.PublishedAtGE != nil {
+		Filter{Columns.News.PublishedAt, *ns.PublishedAtGE, SearchTypeGE, false}.Apply(query)
+	}
+	if ns.IDGT != nil {
+		Filter{Columns.News.ID, *ns.IDGT, SearchTypeGreater, false}.Apply(query)
+	}
+	if ns.IDLE != nil {
+		Filter{Columns.News.ID, *ns.IDLE, SearchTypeLE, false}.Apply(query)
+	}
+	if len(ns.StatusIDs) > 0 {
+		Filter{Columns.News.StatusID, ns.StatusIDs, SearchTypeArray, false}.Apply(query)
+	}
 
 	ns.apply(query)
 
@@ -184,6 +320,62 @@ func (ns *NewsSearch) Q() applier {
 	}
 }
 
+type NewsRevisionSearch struct {
+	search
+
+	ID         *int
+	NewsID     *int
+	CategoryID *int
+	Title      *string
+	Content    *string
+	Actor      *string
+	CreatedAt  *time.Time
+	IDs        []int
+}
+
+func (nrs *NewsRevisionSearch) Apply(query *orm.Query) *orm.Query {
+	if nrs == nil {
+		return query
+	}
+	if nrs.ID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.ID, nrs.ID)
+	}
+	if nrs.NewsID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.NewsID, nrs.NewsID)
+	}
+	if nrs.CategoryID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.CategoryID, nrs.CategoryID)
+	}
+	if nrs.Title != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Title, nrs.Title)
+	}
+	if nrs.Content != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Content, nrs.Content)
+	}
+	if nrs.Actor != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Actor, nrs.Actor)
+	}
+	if nrs.CreatedAt != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.CreatedAt, nrs.CreatedAt)
+	}
+	if len(nrs.IDs) > 0 {
+		Filter{Columns.NewsRevision.ID, nrs.IDs, SearchTypeArray, false}.Apply(query)
+	}
+
+	nrs.apply(query)
+
+	return query
+}
+
+func (nrs *NewsRevisionSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if nrs == nil {
+			return query, nil
+		}
+		return nrs.Apply(query), nil
+	}
+}
+
 type TagSearch struct {
 	search
 
@@ -227,3 +419,123 @@ func (ts *TagSearch) Q() applier {
 		return ts.Apply(query), nil
 	}
 }
+
+type WebhookSearch struct {
+	search
+
+	ID        *int
+	URL       *string
+	Secret    *string
+	CreatedAt *time.Time
+	StatusID  *int
+	IDs       []int
+	EventType *string
+}
+
+func (ws *WebhookSearch) Apply(query *orm.Query) *orm.Query {
+	if ws == nil {
+		return query
+	}
+	if ws.ID != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.ID, ws.ID)
+	}
+	if ws.URL != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.URL, ws.URL)
+	}
+	if ws.Secret != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.Secret, ws.Secret)
+	}
+	if ws.CreatedAt != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.CreatedAt, ws.CreatedAt)
+	}
+	if ws.StatusID != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.StatusID, ws.StatusID)
+	}
+	if len(ws.IDs) > 0 {
+		Filter{Columns.Webhook.ID, ws.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if ws.EventType != nil {
+		Filter{Columns.Webhook.EventTypes, *ws.EventType, SearchTypeArrayContains, false}.Apply(query)
+	}
+
+	ws.apply(query)
+
+	return query
+}
+
+func (ws *WebhookSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if ws == nil {
+			return query, nil
+		}
+		return ws.Apply(query), nil
+	}
+}
+
+type WebhookDeliverySearch struct {
+	search
+
+	ID              *int
+	WebhookID       *int
+	EventType       *string
+	State           *string
+	Attempts        *int
+	NextAttemptAt   *time.Time
+	LastError       *string
+	CreatedAt       *time.Time
+	DeliveredAt     *time.Time
+	IDs             []int
+	NextAttemptAtLE *time.Time
+}
+
+func (wds *WebhookDeliverySearch) Apply(query *orm.Query) *orm.Query {
+	if wds == nil {
+		return query
+	}
+	if wds.ID != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.ID, wds.ID)
+	}
+	if wds.WebhookID != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.WebhookID, wds.WebhookID)
+	}
+	if wds.EventType != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.EventType, wds.EventType)
+	}
+	if wds.State != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.State, wds.State)
+	}
+	if wds.Attempts != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Attempts, wds.Attempts)
+	}
+	if wds.NextAttemptAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.NextAttemptAt, wds.NextAttemptAt)
+	}
+	if wds.LastError != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.LastError, wds.LastError)
+	}
+	if wds.CreatedAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.CreatedAt, wds.CreatedAt)
+	}
+	if wds.DeliveredAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.DeliveredAt, wds.DeliveredAt)
+	}
+	if len(wds.IDs) > 0 {
+		Filter{Columns.WebhookDelivery.ID, wds.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if wds.NextAttemptAtLE != nil {
+		Filter{Columns.WebhookDelivery.NextAttemptAt, *wds.NextAttemptAtLE, SearchTypeLE, false}.Apply(query)
+	}
+
+	wds.apply(query)
+
+	return query
+}
+
+func (wds *WebhookDeliverySearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if wds == nil {
+			return query, nil
+		}
+		return wds.Apply(query), nil
+	}
+}
@@ -14,6 +14,42 @@ const (
 	ErrWrongValue = "value"
 )
 
+func (ak APIKey) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(ak.Title) > 255 {
+		errors[Columns.APIKey.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(ak.KeyHash) > 64 {
+		errors[Columns.APIKey.KeyHash] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(ak.Role) > 16 {
+		errors[Columns.APIKey.Role] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (al AuditLog) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(al.Actor) > 255 {
+		errors[Columns.AuditLog.Actor] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(al.Entity) > 32 {
+		errors[Columns.AuditLog.Entity] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(al.Action) > 16 {
+		errors[Columns.AuditLog.Action] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
 func (c Category) Validate() (errors map[string]string, valid bool) {
 	errors = map[string]string{}
 
@@ -38,6 +74,20 @@ func (n News) Validate() (errors map[string]string, valid bool) {
 	return errors, len(errors) == 0
 }
 
+func (nr NewsRevision) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(nr.Title) > 255 {
+		errors[Columns.NewsRevision.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(nr.Actor) > 255 {
+		errors[Columns.NewsRevision.Actor] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
 func (t Tag) Validate() (errors map[string]string, valid bool) {
 	errors = map[string]string{}
 
@@ -47,3 +97,31 @@ func (t Tag) Validate() (errors map[string]string, valid bool) {
 
 	return errors, len(errors) == 0
 }
+
+func (w Webhook) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(w.URL) > 2048 {
+		errors[Columns.Webhook.URL] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(w.Secret) > 255 {
+		errors[Columns.Webhook.Secret] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (wd WebhookDelivery) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(wd.EventType) > 32 {
+		errors[Columns.WebhookDelivery.EventType] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(wd.State) > 16 {
+		errors[Columns.WebhookDelivery.State] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
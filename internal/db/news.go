@@ -21,18 +21,22 @@ func NewNewsRepo(db orm.DB) NewsRepo {
 		db: db,
 		filters: map[string][]Filter{
 			Tables.Category.Name: {StatusFilter},
-			Tables.News.Name:     {StatusFilter},
+			Tables.News.Name:     {NewsStatusFilter},
 			Tables.Tag.Name:      {StatusFilter},
 		},
 		sort: map[string][]SortField{
-			Tables.Category.Name: {{Column: Columns.Category.Title, Direction: SortAsc}},
-			Tables.News.Name:     {{Column: Columns.News.Title, Direction: SortAsc}},
-			Tables.Tag.Name:      {{Column: Columns.Tag.Title, Direction: SortAsc}},
+			Tables.AuditLog.Name:     {{Column: Columns.AuditLog.CreatedAt, Direction: SortDesc}},
+			Tables.Category.Name:     {{Column: Columns.Category.Title, Direction: SortAsc}},
+			Tables.News.Name:         {{Column: Columns.News.Title, Direction: SortAsc}},
+			Tables.NewsRevision.Name: {{Column: Columns.NewsRevision.CreatedAt, Direction: SortDesc}},
+			Tables.Tag.Name:          {{Column: Columns.Tag.Title, Direction: SortAsc}},
 		},
 		join: map[string][]string{
-			Tables.Category.Name: {TableColumns},
-			Tables.News.Name:     {TableColumns, Columns.News.Category},
-			Tables.Tag.Name:      {TableColumns},
+			Tables.AuditLog.Name:     {TableColumns},
+			Tables.Category.Name:     {TableColumns},
+			Tables.News.Name:         {TableColumns, Columns.News.Category},
+			Tables.NewsRevision.Name: {TableColumns},
+			Tables.Tag.Name:          {TableColumns},
 		},
 	}
 }
@@ -56,6 +60,57 @@ func (nr NewsRepo) WithEnabledOnly() NewsRepo {
 	return nr
 }
 
+/*** AuditLog ***/
+
+// FullAuditLog returns full joins with all columns
+func (nr NewsRepo) FullAuditLog() OpFunc {
+	return WithColumns(nr.join[Tables.AuditLog.Name]...)
+}
+
+// DefaultAuditLogSort returns default sort.
+func (nr NewsRepo) DefaultAuditLogSort() OpFunc {
+	return WithSort(nr.sort[Tables.AuditLog.Name]...)
+}
+
+// AuditLogByID is a function that returns AuditLog by ID(s) or nil.
+func (nr NewsRepo) AuditLogByID(ctx context.Context, id int, ops ...OpFunc) (*AuditLog, error) {
+	return nr.OneAuditLog(ctx, &AuditLogSearch{ID: &id}, ops...)
+}
+
+// OneAuditLog is a function that returns one AuditLog by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneAuditLog(ctx context.Context, search *AuditLogSearch, ops ...OpFunc) (*AuditLog, error) {
+	obj := &AuditLog{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.AuditLog.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// AuditLogsByFilters returns AuditLog list.
+func (nr NewsRepo) AuditLogsByFilters(ctx context.Context, search *AuditLogSearch, pager Pager, ops ...OpFunc) (auditLogs []AuditLog, err error) {
+	err = buildQuery(ctx, nr.db, &auditLogs, search, nr.filters[Tables.AuditLog.Name], pager, ops...).Select()
+	return
+}
+
+// CountAuditLogs returns count
+func (nr NewsRepo) CountAuditLogs(ctx context.Context, search *AuditLogSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &AuditLog{}, search, nr.filters[Tables.AuditLog.Name], PagerOne, ops...).Count()
+}
+
+// AddAuditLog adds AuditLog to DB.
+func (nr NewsRepo) AddAuditLog(ctx context.Context, auditLog *AuditLog, ops ...OpFunc) (*AuditLog, error) {
+	q := nr.db.ModelContext(ctx, auditLog)
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return auditLog, err
+}
+
 /*** Category ***/
 
 // FullCategory returns full joins with all columns
@@ -202,6 +257,57 @@ func (nr NewsRepo) DeleteNews(ctx context.Context, id int) (deleted bool, err er
 	return nr.UpdateNews(ctx, news, WithColumns(Columns.News.StatusID))
 }
 
+/*** NewsRevision ***/
+
+// FullNewsRevision returns full joins with all columns
+func (nr NewsRepo) FullNewsRevision() OpFunc {
+	return WithColumns(nr.join[Tables.NewsRevision.Name]...)
+}
+
+// DefaultNewsRevisionSort returns default sort.
+func (nr NewsRepo) DefaultNewsRevisionSort() OpFunc {
+	return WithSort(nr.sort[Tables.NewsRevision.Name]...)
+}
+
+// NewsRevisionByID is a function that returns NewsRevision by ID(s) or nil.
+func (nr NewsRepo) NewsRevisionByID(ctx context.Context, id int, ops ...OpFunc) (*NewsRevision, error) {
+	return nr.OneNewsRevision(ctx, &NewsRevisionSearch{ID: &id}, ops...)
+}
+
+// OneNewsRevision is a function that returns one NewsRevision by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneNewsRevision(ctx context.Context, search *NewsRevisionSearch, ops ...OpFunc) (*NewsRevision, error) {
+	obj := &NewsRevision{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.NewsRevision.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// NewsRevisionsByFilters returns NewsRevision list.
+func (nr NewsRepo) NewsRevisionsByFilters(ctx context.Context, search *NewsRevisionSearch, pager Pager, ops ...OpFunc) (newsRevisions []NewsRevision, err error) {
+	err = buildQuery(ctx, nr.db, &newsRevisions, search, nr.filters[Tables.NewsRevision.Name], pager, ops...).Select()
+	return
+}
+
+// CountNewsRevisions returns count
+func (nr NewsRepo) CountNewsRevisions(ctx context.Context, search *NewsRevisionSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &NewsRevision{}, search, nr.filters[Tables.NewsRevision.Name], PagerOne, ops...).Count()
+}
+
+// AddNewsRevision adds NewsRevision to DB.
+func (nr NewsRepo) AddNewsRevision(ctx context.Context, newsRevision *NewsRevision, ops ...OpFunc) (*NewsRevision, error) {
+	q := nr.db.ModelContext(ctx, newsRevision)
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return newsRevision, err
+}
+
 /*** Tag ***/
 
 // FullTag returns full joins with all columns
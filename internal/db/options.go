@@ -17,11 +17,19 @@ const (
 	StatusEnabled  = 1
 	StatusDisabled = 2
 	StatusDeleted  = 3
+
+	// news workflow statuses, published news are enabled
+	StatusPublished = StatusEnabled
+	StatusDraft     = 4
+	StatusInReview  = 5
+	StatusScheduled = 6
+	StatusArchived  = 7
 )
 
 var (
 	StatusFilter        = Filter{Field: "statusId", Value: []int{StatusEnabled, StatusDisabled}, SearchType: SearchTypeArray}
 	StatusEnabledFilter = Filter{Field: "statusId", Value: []int{StatusEnabled}, SearchType: SearchTypeArray}
+	NewsStatusFilter    = Filter{Field: "statusId", Value: []int{StatusDraft, StatusInReview, StatusScheduled, StatusPublished, StatusArchived}, SearchType: SearchTypeArray}
 )
 
 type SortDirection string
@@ -114,6 +122,13 @@ func EnabledOnly() OpFunc {
 	}
 }
 
+// ForUpdate locks selected rows of table alias until the end of transaction. Joined relations are not locked.
+func ForUpdate(tableAlias string) OpFunc {
+	return func(query *orm.Query) {
+		query.For("UPDATE OF ?", pg.Ident(tableAlias))
+	}
+}
+
 // WithJoinedIDs adds join VALUES statement for given table and column.
 func WithJoinedIDs(ids []int, tableAlias, column string) OpFunc {
 	return func(q *orm.Query) {
@@ -11,10 +11,6 @@ import (
 	"github.com/go-pg/pg/v10"
 )
 
-const (
-	StatusPublished = 1
-)
-
 type Repository struct {
 	db pg.DBI
 }
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// WithNewsSeek adds keyset condition for news sorted by publishedAt DESC, newsId DESC.
+// Query returns news after (publishedAt, newsID) position in this order.
+func WithNewsSeek(publishedAt time.Time, newsID int) OpFunc {
+	return func(q *orm.Query) {
+		q.Where("(?.?, ?.?) < (?, ?)",
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.PublishedAt),
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID),
+			publishedAt, newsID,
+		)
+	}
+}
+
+// WithNewsSeekAfter adds keyset condition for news sorted by publishedAt ASC, newsId ASC.
+// Query returns news after (publishedAt, newsID) position in this order.
+func WithNewsSeekAfter(publishedAt time.Time, newsID int) OpFunc {
+	return func(q *orm.Query) {
+		q.Where("(?.?, ?.?) > (?, ?)",
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.PublishedAt),
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID),
+			publishedAt, newsID,
+		)
+	}
+}
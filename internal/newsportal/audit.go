@@ -0,0 +1,155 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+)
+
+// Audited entities.
+const (
+	AuditEntityNews     = "news"
+	AuditEntityCategory = "category"
+	AuditEntityTag      = "tag"
+)
+
+// Audited actions.
+const (
+	AuditActionCreate     = "create"
+	AuditActionUpdate     = "update"
+	AuditActionDelete     = "delete"
+	AuditActionTransition = "transition"
+)
+
+// auditAnonymous is an actor of changes made without authentication.
+const auditAnonymous = "anonymous"
+
+// auditLogSort is a stable audit log order: createdAt DESC, auditLogId DESC.
+var auditLogSort = []db.SortField{
+	db.NewSortField(db.Columns.AuditLog.CreatedAt, true),
+	db.NewSortField(db.Columns.AuditLog.ID, true),
+}
+
+// AuditChange is an old and new value of changed column.
+type AuditChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// AuditLog is an editorial change. Diff contains AuditChange values keyed by db column name.
+type AuditLog struct {
+	db.AuditLog
+}
+
+// AuditLogFilter filters audit log. All fields are optional, time range is [From, To).
+type AuditLogFilter struct {
+	Entity   *string
+	EntityID *int
+	From     *time.Time
+	To       *time.Time
+}
+
+// AuditLog returns audit log entries matching filter, the newest first.
+func (u *Manager) AuditLog(ctx context.Context, filter AuditLogFilter, page, pageSize *int) ([]AuditLog, error) {
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	search := &db.AuditLogSearch{
+		Entity:      filter.Entity,
+		EntityID:    filter.EntityID,
+		CreatedAtGE: filter.From,
+		CreatedAtLT: filter.To,
+	}
+
+	list, err := u.editRepo.AuditLogsByFilters(ctx, search, db.NewPager(p, ps), db.WithSort(auditLogSort...))
+	if err != nil {
+		return nil, fmt.Errorf("db get audit log: %w", err)
+	}
+
+	return Map(list, func(l db.AuditLog) AuditLog { return AuditLog{AuditLog: l} }), nil
+}
+
+// recordAudit adds audit log entry with diff of old and new entity. Old is nil for created entities.
+// Actor is a principal subject from context. It must be called with manager bound to the change transaction.
+func (u *Manager) recordAudit(ctx context.Context, entity string, entityID int, action string, old, new any) error {
+	entry := &db.AuditLog{
+		Actor:     actorFromContext(ctx),
+		Entity:    entity,
+		EntityID:  entityID,
+		Action:    action,
+		Diff:      auditDiff(old, new),
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := u.editRepo.AddAuditLog(ctx, entry); err != nil {
+		return fmt.Errorf("db add audit log: %w", err)
+	}
+
+	return nil
+}
+
+// actorFromContext returns principal subject from context or auditAnonymous.
+func actorFromContext(ctx context.Context) string {
+	if p := PrincipalFromContext(ctx); p != nil && p.Subject != "" {
+		return p.Subject
+	}
+
+	return auditAnonymous
+}
+
+// auditDiff returns changed columns of two db models of the same type. Relations are skipped.
+// Nil old or new model means all columns are changed from or to null.
+func auditDiff(old, new any) map[string]any {
+	diff := map[string]any{}
+
+	ov, nv := reflect.Indirect(reflect.ValueOf(old)), reflect.Indirect(reflect.ValueOf(new))
+	t := nv.Type()
+	if !nv.IsValid() {
+		t = ov.Type()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		column, _, _ := strings.Cut(f.Tag.Get("pg"), ",")
+		if !f.IsExported() || column == "" || column == "-" || strings.HasPrefix(column, "fk:") {
+			continue
+		}
+
+		change := AuditChange{Old: auditValue(ov, i), New: auditValue(nv, i)}
+		if !reflect.DeepEqual(change.Old, change.New) {
+			diff[column] = change
+		}
+	}
+
+	return diff
+}
+
+// auditValue returns comparable field value of struct or nil for invalid struct and nil pointers.
+// Times are converted to UTC with database precision.
+func auditValue(v reflect.Value, field int) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	f := reflect.Indirect(v.Field(field))
+	if !f.IsValid() {
+		return nil
+	}
+
+	switch value := f.Interface().(type) {
+	case time.Time:
+		return value.UTC().Round(time.Microsecond)
+	case []int:
+		if len(value) == 0 {
+			return nil
+		}
+	}
+
+	return f.Interface()
+}
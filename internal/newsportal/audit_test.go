@@ -0,0 +1,147 @@
+package newsportal
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDiff(t *testing.T) {
+	content := "content"
+	old := db.News{
+		ID:          1,
+		CategoryID:  1,
+		Title:       "Old",
+		Content:     &content,
+		Author:      "Author",
+		PublishedAt: db.BaseTime,
+		TagIDs:      []int{1},
+		StatusID:    db.StatusEnabled,
+		Category:    &db.Category{ID: 1},
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		diff := auditDiff(nil, old)
+		assert.Equal(t, AuditChange{New: "Old"}, diff[db.Columns.News.Title])
+		assert.Equal(t, AuditChange{New: content}, diff[db.Columns.News.Content])
+		assert.NotContains(t, diff, db.Columns.News.UpdatedAt, "expected null columns to be skipped")
+		assert.NotContains(t, diff, "fk:categoryId", "expected relations to be skipped")
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		updated := old
+		updated.Title = "New"
+		updated.PublishedAt = db.BaseTime.In(time.FixedZone("UTC+3", 3*60*60))
+		updated.TagIDs = []int{1, 2}
+		updated.Category = nil
+
+		diff := auditDiff(&old, updated)
+		assert.Equal(t, map[string]any{
+			db.Columns.News.Title:  AuditChange{Old: "Old", New: "New"},
+			db.Columns.News.TagIDs: AuditChange{Old: []int{1}, New: []int{1, 2}},
+		}, diff)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		deleted := old
+		deleted.StatusID = db.StatusDeleted
+
+		diff := auditDiff(old, deleted)
+		assert.Equal(t, map[string]any{
+			db.Columns.News.StatusID: AuditChange{Old: db.StatusEnabled, New: db.StatusDeleted},
+		}, diff)
+	})
+}
+
+func TestManager_AuditLog_Integration(t *testing.T) {
+	_, ctx, manager := withTx(t)
+	ctx = WithPrincipal(ctx, &Principal{Subject: "editor@example.com", Role: RoleEditor})
+
+	entityLog := func(t *testing.T, entity string, id int) []AuditLog {
+		t.Helper()
+
+		list, err := manager.AuditLog(ctx, AuditLogFilter{Entity: &entity, EntityID: &id}, nil, nil)
+		require.NoError(t, err)
+
+		return list
+	}
+
+	t.Run("News", func(t *testing.T) {
+		content := "Audited content"
+		// only admin creates published news
+		adminCtx := WithPrincipal(ctx, &Principal{Subject: "admin@example.com", Role: RoleAdmin})
+		created, err := manager.CreateNews(adminCtx, News{News: db.News{
+			CategoryID:  1,
+			Title:       "Audited News",
+			Content:     &content,
+			Author:      "Editor",
+			PublishedAt: db.BaseTime,
+			TagIDs:      []int{1},
+			StatusID:    db.StatusPublished,
+		}})
+		require.NoError(t, err)
+
+		in := News{News: created.News}
+		in.Title = "Audited News Updated"
+		_, err = manager.UpdateNews(ctx, in)
+		require.NoError(t, err)
+		require.NoError(t, manager.DeleteNews(ctx, created.ID))
+
+		list := entityLog(t, AuditEntityNews, created.ID)
+		require.Len(t, list, 3)
+
+		deleted, updated, added := list[0], list[1], list[2]
+		assert.Equal(t, AuditActionCreate, added.Action)
+		assert.Equal(t, AuditActionUpdate, updated.Action)
+		assert.Equal(t, AuditActionDelete, deleted.Action)
+		assert.Equal(t, "editor@example.com", updated.Actor)
+
+		assert.Contains(t, added.Diff, db.Columns.News.Title)
+		assert.Equal(t, map[string]any{"old": "Audited News", "new": "Audited News Updated"}, updated.Diff[db.Columns.News.Title])
+		assert.Contains(t, updated.Diff, db.Columns.News.UpdatedAt)
+		assert.NotContains(t, updated.Diff, db.Columns.News.Author, "expected unchanged columns to be skipped")
+		assert.Equal(t, map[string]any{db.Columns.News.StatusID: map[string]any{"old": float64(db.StatusPublished), "new": float64(db.StatusDeleted)}}, deleted.Diff)
+	})
+
+	t.Run("CategoryAndTag", func(t *testing.T) {
+		category, err := manager.CreateCategory(ctx, Category{Category: db.Category{Title: "Audited", StatusID: db.StatusEnabled}})
+		require.NoError(t, err)
+		require.NoError(t, manager.DeleteCategory(ctx, category.ID))
+
+		tag, err := manager.CreateTag(ctx, Tag{Tag: db.Tag{Title: "Audited", StatusID: db.StatusEnabled}})
+		require.NoError(t, err)
+
+		in := *tag
+		in.Title = "Audited Updated"
+		_, err = manager.UpdateTag(ctx, in)
+		require.NoError(t, err)
+
+		assert.Len(t, entityLog(t, AuditEntityCategory, category.ID), 2)
+		assert.Len(t, entityLog(t, AuditEntityTag, tag.ID), 2)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		tag, err := manager.CreateTag(t.Context(), Tag{Tag: db.Tag{Title: "Anonymous", StatusID: db.StatusEnabled}})
+		require.NoError(t, err)
+
+		list := entityLog(t, AuditEntityTag, tag.ID)
+		require.Len(t, list, 1)
+		assert.Equal(t, auditAnonymous, list[0].Actor)
+	})
+
+	t.Run("TimeRange", func(t *testing.T) {
+		from := time.Now().Add(-time.Hour)
+		list, err := manager.AuditLog(ctx, AuditLogFilter{From: &from}, nil, nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, list)
+
+		list, err = manager.AuditLog(ctx, AuditLogFilter{To: &from}, nil, nil)
+		require.NoError(t, err)
+		for _, l := range list {
+			assert.True(t, l.CreatedAt.Before(from))
+		}
+	})
+}
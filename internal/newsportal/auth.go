@@ -0,0 +1,314 @@
+package newsportal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrUnauthorized is returned for missing, invalid or expired credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is returned when principal role is not enough for operation.
+	ErrForbidden = errors.New("forbidden")
+	// ErrAuthDisabled is returned for operations requiring role when authentication is disabled.
+	ErrAuthDisabled = fmt.Errorf("%w: authentication is disabled", ErrForbidden)
+)
+
+// Role is a principal role. Each role includes permissions of the previous one: reader < editor < admin.
+type Role string
+
+const (
+	RoleReader Role = "reader"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+const (
+	// HeaderAPIKey contains API key. JWT token is passed in Authorization: Bearer header.
+	HeaderAPIKey = "X-API-Key"
+
+	// apiKeyPrefix marks generated API keys.
+	apiKeyPrefix = "np_"
+)
+
+var roleLevels = map[Role]int{
+	RoleReader: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Valid reports whether role is known.
+func (r Role) Valid() bool {
+	_, ok := roleLevels[r]
+	return ok
+}
+
+// Allows reports whether role includes required role.
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleLevels[r] >= roleLevels[required]
+}
+
+// Principal is an authenticated API client.
+type Principal struct {
+	// Subject is a JWT subject or api key title.
+	Subject string
+	Role    Role
+	// APIKeyID is set for principals authenticated by API key.
+	APIKeyID *int
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns context with principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns principal from context or nil for anonymous requests.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// RequireRole checks principal from context. Returns ErrUnauthorized without principal and ErrForbidden
+// if principal role does not include required role. Empty role is allowed for anyone.
+func RequireRole(ctx context.Context, required Role) error {
+	if required == "" {
+		return nil
+	}
+
+	p := PrincipalFromContext(ctx)
+	switch {
+	case p == nil:
+		return ErrUnauthorized
+	case !p.Role.Allows(required):
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthConfig configures authentication.
+type AuthConfig struct {
+	// Enabled turns on authentication. Without it read methods are public and methods requiring role are forbidden.
+	Enabled bool
+	// JWTSecret is a HMAC secret of JWT tokens.
+	JWTSecret string
+	// JWTIssuer is an optional expected iss claim.
+	JWTIssuer string
+	// ReadRole is a role required for read methods. Empty role means read methods are public.
+	ReadRole Role
+}
+
+// tokenClaims are JWT claims with role.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Role Role `json:"role"`
+}
+
+// APIKey is an API key without key value.
+type APIKey struct {
+	db.APIKey
+}
+
+type AuthManager struct {
+	repo   db.AuthRepo
+	config AuthConfig
+}
+
+func NewAuthManager(dbc orm.DB, cfg AuthConfig) *AuthManager {
+	return &AuthManager{
+		repo:   db.NewAuthRepo(dbc),
+		config: cfg,
+	}
+}
+
+// Config returns authentication config.
+func (a *AuthManager) Config() AuthConfig {
+	return a.config
+}
+
+// Enabled reports whether authentication is enabled, nil manager means disabled authentication.
+func (a *AuthManager) Enabled() bool {
+	return a != nil && a.config.Enabled
+}
+
+// Authenticate returns principal by API key or bearer JWT token, API key is checked first.
+// Returns nil principal without credentials and ErrUnauthorized for invalid ones.
+func (a *AuthManager) Authenticate(ctx context.Context, apiKey, token string) (*Principal, error) {
+	switch {
+	case apiKey != "":
+		return a.authenticateAPIKey(ctx, apiKey)
+	case token != "":
+		return a.authenticateToken(token)
+	}
+
+	return nil, nil
+}
+
+// AuthenticateRequest returns principal by X-API-Key or Authorization: Bearer headers of request.
+func (a *AuthManager) AuthenticateRequest(ctx context.Context, r *http.Request) (*Principal, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = ""
+	}
+
+	return a.Authenticate(ctx, r.Header.Get(HeaderAPIKey), strings.TrimSpace(token))
+}
+
+// authenticateAPIKey returns principal of enabled not expired API key.
+func (a *AuthManager) authenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	hash := hashAPIKey(key)
+	apiKey, err := a.repo.WithEnabledOnly().OneAPIKey(ctx, &db.APIKeySearch{KeyHash: &hash})
+	if err != nil {
+		return nil, fmt.Errorf("db get api key: %w", err)
+	} else if apiKey == nil || (apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now())) {
+		return nil, ErrUnauthorized
+	}
+
+	return &Principal{
+		Subject:  apiKey.Title,
+		Role:     Role(apiKey.Role),
+		APIKeyID: &apiKey.ID,
+	}, nil
+}
+
+// authenticateToken returns principal of valid HS256 token with known role.
+func (a *AuthManager) authenticateToken(token string) (*Principal, error) {
+	if a.config.JWTSecret == "" {
+		return nil, ErrUnauthorized
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if a.config.JWTIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.config.JWTIssuer))
+	}
+
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return []byte(a.config.JWTSecret), nil
+	}, opts...)
+	if err != nil || !claims.Role.Valid() {
+		return nil, ErrUnauthorized
+	}
+
+	return &Principal{Subject: claims.Subject, Role: claims.Role}, nil
+}
+
+// NewToken returns HS256 JWT token of subject with role, valid for ttl.
+func (a *AuthManager) NewToken(subject string, role Role, ttl time.Duration) (string, error) {
+	if a.config.JWTSecret == "" {
+		return "", errors.New("jwt secret is not configured")
+	} else if !role.Valid() {
+		return "", ValidationError{Fields: map[string]string{db.Columns.APIKey.Role: db.ErrWrongValue}}
+	}
+
+	now := time.Now()
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    a.config.JWTIssuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Role: role,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(a.config.JWTSecret))
+}
+
+// CreateAPIKey adds API key with role. Returns generated key, it is not stored and can't be retrieved later.
+func (a *AuthManager) CreateAPIKey(ctx context.Context, title string, role Role, expiresAt *time.Time) (string, *APIKey, error) {
+	errs := map[string]string{}
+	if strings.TrimSpace(title) == "" {
+		errs[db.Columns.APIKey.Title] = db.ErrEmptyValue
+	}
+	if !role.Valid() {
+		errs[db.Columns.APIKey.Role] = db.ErrWrongValue
+	}
+
+	key, err := newAPIKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("generate api key: %w", err)
+	}
+
+	apiKey := db.APIKey{
+		Title:     title,
+		KeyHash:   hashAPIKey(key),
+		Role:      string(role),
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+		StatusID:  db.StatusEnabled,
+	}
+	if fields, ok := apiKey.Validate(); !ok {
+		for field, reason := range fields {
+			errs[field] = reason
+		}
+	}
+	if len(errs) > 0 {
+		return "", nil, ValidationError{Fields: errs}
+	}
+
+	if _, err := a.repo.AddAPIKey(ctx, &apiKey); err != nil {
+		return "", nil, fmt.Errorf("db add api key: %w", err)
+	}
+
+	return key, &APIKey{APIKey: apiKey}, nil
+}
+
+// APIKeys returns not deleted API keys, the newest first.
+func (a *AuthManager) APIKeys(ctx context.Context) ([]APIKey, error) {
+	list, err := a.repo.APIKeysByFilters(ctx, nil, db.PagerNoLimit, a.repo.DefaultAPIKeySort())
+	if err != nil {
+		return nil, fmt.Errorf("db get api keys: %w", err)
+	}
+
+	return Map(list, func(k db.APIKey) APIKey { return APIKey{APIKey: k} }), nil
+}
+
+// DeleteAPIKey marks API key as deleted. Returns ErrNotFound for unknown or already deleted key.
+func (a *AuthManager) DeleteAPIKey(ctx context.Context, id int) error {
+	existing, err := a.repo.APIKeyByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("db get api key by id: %w", err)
+	} else if existing == nil {
+		return ErrNotFound
+	}
+
+	if _, err := a.repo.DeleteAPIKey(ctx, id); err != nil {
+		return fmt.Errorf("db delete api key: %w", err)
+	}
+
+	return nil
+}
+
+// newAPIKey returns random API key.
+func newAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPIKey returns hex sha256 of API key.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
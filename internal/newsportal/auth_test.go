@@ -0,0 +1,184 @@
+package newsportal
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "test-secret"
+
+func TestRole_Allows(t *testing.T) {
+	assert.True(t, RoleAdmin.Allows(RoleEditor))
+	assert.True(t, RoleEditor.Allows(RoleEditor))
+	assert.True(t, RoleEditor.Allows(RoleReader))
+	assert.False(t, RoleReader.Allows(RoleEditor))
+	assert.False(t, RoleEditor.Allows(RoleAdmin))
+	assert.False(t, Role("root").Allows(RoleReader))
+}
+
+func TestRequireRole(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, RequireRole(ctx, ""), "expected empty role to be public")
+	assert.ErrorIs(t, RequireRole(ctx, RoleReader), ErrUnauthorized)
+
+	ctx = WithPrincipal(ctx, &Principal{Subject: "reader", Role: RoleReader})
+	assert.NoError(t, RequireRole(ctx, RoleReader))
+	assert.ErrorIs(t, RequireRole(ctx, RoleEditor), ErrForbidden)
+}
+
+func TestAuthManager_Token(t *testing.T) {
+	ctx := context.Background()
+	auth := NewAuthManager(nil, AuthConfig{Enabled: true, JWTSecret: testJWTSecret, JWTIssuer: "news-portal"})
+
+	t.Run("Valid", func(t *testing.T) {
+		token, err := auth.NewToken("editor@example.com", RoleEditor, time.Minute)
+		require.NoError(t, err)
+
+		p, err := auth.Authenticate(ctx, "", token)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, "editor@example.com", p.Subject)
+		assert.Equal(t, RoleEditor, p.Role)
+		assert.Nil(t, p.APIKeyID)
+	})
+
+	t.Run("FromRequest", func(t *testing.T) {
+		token, err := auth.NewToken("admin", RoleAdmin, time.Minute)
+		require.NoError(t, err)
+
+		r := httptest.NewRequest("POST", "/rpc", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		p, err := auth.AuthenticateRequest(ctx, r)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, RoleAdmin, p.Role)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		p, err := auth.Authenticate(ctx, "", "")
+		require.NoError(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token, err := auth.NewToken("editor", RoleEditor, -time.Minute)
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, "", token)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("WrongSecret", func(t *testing.T) {
+		other := NewAuthManager(nil, AuthConfig{Enabled: true, JWTSecret: "other", JWTIssuer: "news-portal"})
+		token, err := other.NewToken("editor", RoleEditor, time.Minute)
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, "", token)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("WrongIssuer", func(t *testing.T) {
+		other := NewAuthManager(nil, AuthConfig{Enabled: true, JWTSecret: testJWTSecret, JWTIssuer: "other"})
+		token, err := other.NewToken("editor", RoleEditor, time.Minute)
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, "", token)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("UnknownRole", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "news-portal",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			Role: "root",
+		}).SignedString([]byte(testJWTSecret))
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, "", token)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("NoneAlgorithm", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, tokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "news-portal",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			Role: RoleAdmin,
+		}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, "", token)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestAuthManager_APIKey_Integration(t *testing.T) {
+	tx, ctx, _ := withTx(t)
+	auth := NewAuthManager(tx, AuthConfig{Enabled: true})
+
+	t.Run("CreateAndAuthenticate", func(t *testing.T) {
+		key, apiKey, err := auth.CreateAPIKey(ctx, "CI", RoleEditor, nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, key)
+		assert.NotEqual(t, key, apiKey.KeyHash, "expected key to be stored as hash")
+
+		p, err := auth.Authenticate(ctx, key, "")
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, "CI", p.Subject)
+		assert.Equal(t, RoleEditor, p.Role)
+		require.NotNil(t, p.APIKeyID)
+		assert.Equal(t, apiKey.ID, *p.APIKeyID)
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		_, err := auth.Authenticate(ctx, "np_unknown", "")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("ExpiredKey", func(t *testing.T) {
+		expired := time.Now().Add(-time.Hour)
+		key, _, err := auth.CreateAPIKey(ctx, "Expired", RoleReader, &expired)
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(ctx, key, "")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("DeletedKey", func(t *testing.T) {
+		key, apiKey, err := auth.CreateAPIKey(ctx, "Deleted", RoleAdmin, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, auth.DeleteAPIKey(ctx, apiKey.ID))
+		_, err = auth.Authenticate(ctx, key, "")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+
+		assert.ErrorIs(t, auth.DeleteAPIKey(ctx, apiKey.ID), ErrNotFound)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		_, _, err := auth.CreateAPIKey(ctx, " ", "root", nil)
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Contains(t, vErr.Fields, "title")
+		assert.Contains(t, vErr.Fields, "role")
+	})
+
+	t.Run("List", func(t *testing.T) {
+		keys, err := auth.APIKeys(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, keys)
+	})
+}
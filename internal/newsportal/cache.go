@@ -0,0 +1,292 @@
+package newsportal
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	cacheKeyCategories = "categories"
+	cacheKeyTags       = "tags"
+	// cacheKeyNewsPrefix is a prefix of news lists, counts and items.
+	cacheKeyNewsPrefix = "news:"
+)
+
+// Cache stores values by key with per-entry TTL. Implementations must be safe for concurrent use.
+// Cached values are shared between callers and must not be modified.
+type Cache interface {
+	Get(key string) (any, bool)
+	Set(key string, value any, ttl time.Duration)
+	Delete(key string)
+	DeletePrefix(prefix string)
+	Stats() CacheStats
+}
+
+// CacheStats contains cache hit and miss counters.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+// HitRatio returns hits to lookups ratio, or 0 without lookups.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+// CacheConfig configures Manager cache. Zero TTL disables caching of the entry kind.
+type CacheConfig struct {
+	// Enabled turns on in-memory cache.
+	Enabled bool
+	// Size is a max number of cached entries.
+	Size int
+	// CategoriesTTL and TagsTTL are TTLs of category and tag lists.
+	CategoriesTTL time.Duration
+	TagsTTL       time.Duration
+	// NewsTTL is a TTL of news lists, counts and items.
+	NewsTTL time.Duration
+}
+
+// MemoryCache is an in-memory LRU cache with TTL.
+type MemoryCache struct {
+	mu      sync.Mutex
+	maxSize int
+	items   map[string]*list.Element
+	// order contains *memoryEntry, front is the most recently used
+	order *list.List
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type memoryEntry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+// NewMemoryCache returns LRU cache with up to maxSize entries. Non-positive maxSize means 1000 entries.
+func NewMemoryCache(maxSize int) *MemoryCache {
+	if maxSize <= 0 {
+		maxSize = 1000
+	}
+
+	return &MemoryCache{
+		maxSize: maxSize,
+		items:   make(map[string]*list.Element, maxSize),
+		order:   list.New(),
+	}
+}
+
+// Get returns not expired value and marks it as recently used.
+func (c *MemoryCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(el)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	c.hits.Add(1)
+
+	return entry.value, true
+}
+
+// Set stores value for ttl and evicts the least recently used entry if cache is full.
+func (c *MemoryCache) Set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*memoryEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.maxSize {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// DeletePrefix deletes all entries with key prefix.
+func (c *MemoryCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *MemoryCache) Stats() CacheStats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+		Size:   size,
+	}
+}
+
+// remove deletes list element, must be called with lock held.
+func (c *MemoryCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*memoryEntry).key)
+}
+
+// cacheLoads deduplicates concurrent loads of cache entries and counts invalidations. Each invalidation starts
+// a new epoch: loads started in previous epoch don't store their results and new callers don't wait for them,
+// so values read before invalidation never get into cache after it.
+type cacheLoads struct {
+	group singleflight.Group
+
+	mu    sync.Mutex
+	epoch uint64
+}
+
+// current returns current epoch.
+func (l *cacheLoads) current() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.epoch
+}
+
+// set stores value loaded in epoch if there were no invalidations since.
+func (l *cacheLoads) set(cache Cache, epoch uint64, key string, value any, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.epoch == epoch {
+		cache.Set(key, value, ttl)
+	}
+}
+
+// invalidate starts new epoch and deletes entries, concurrent set waits for it.
+func (l *cacheLoads) invalidate(deleteEntries func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.epoch++
+	deleteEntries()
+}
+
+// cached returns value from manager cache or loads it. Concurrent loads of the same key are deduplicated,
+// load runs with context without cancel, so canceled caller doesn't fail other callers waiting for the same key.
+// Value is not cached if cache was invalidated during load.
+// Without cache, with zero ttl or in transaction value is always loaded, so it is read from transaction snapshot.
+func cached[T any](ctx context.Context, u *Manager, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	if u.cache == nil || ttl <= 0 || u.inTx {
+		return load(ctx)
+	}
+
+	if v, ok := u.cache.Get(key); ok {
+		return v.(T), nil
+	}
+
+	epoch := u.loads.current()
+	ch := u.loads.group.DoChan(fmt.Sprintf("%s@%d", key, epoch), func() (any, error) {
+		r, err := load(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		u.loads.set(u.cache, epoch, key, r, ttl)
+		return r, nil
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return zero, r.Err
+		}
+
+		return r.Val.(T), nil
+	}
+}
+
+// newsCacheKey returns cache key of news list or count with filters.
+func newsCacheKey(kind string, params ...*int) string {
+	var b strings.Builder
+	b.WriteString(cacheKeyNewsPrefix)
+	b.WriteString(kind)
+	for _, p := range params {
+		if p == nil {
+			b.WriteString(":-")
+		} else {
+			fmt.Fprintf(&b, ":%d", *p)
+		}
+	}
+
+	return b.String()
+}
+
+// invalidateNews drops cached news lists, counts and items.
+func (u *Manager) invalidateNews() {
+	u.invalidate()
+}
+
+// invalidateCategories drops cached categories and news with embedded categories.
+func (u *Manager) invalidateCategories() {
+	u.invalidate(cacheKeyCategories)
+}
+
+// invalidateTags drops cached tags and news with embedded tags.
+func (u *Manager) invalidateTags() {
+	u.invalidate(cacheKeyTags)
+}
+
+// invalidate drops cached news and entries with keys in new cache epoch.
+func (u *Manager) invalidate(keys ...string) {
+	if u.cache == nil {
+		return
+	}
+
+	u.loads.invalidate(func() {
+		for _, key := range keys {
+			u.cache.Delete(key)
+		}
+		u.cache.DeletePrefix(cacheKeyNewsPrefix)
+	})
+}
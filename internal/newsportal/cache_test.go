@@ -0,0 +1,235 @@
+package newsportal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	t.Run("GetSetAndStats", func(t *testing.T) {
+		c := NewMemoryCache(10)
+
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+
+		c.Set("a", 1, time.Minute)
+		v, ok := c.Get("a")
+		require.True(t, ok)
+		assert.Equal(t, 1, v)
+
+		stats := c.Stats()
+		assert.Equal(t, uint64(1), stats.Hits)
+		assert.Equal(t, uint64(1), stats.Misses)
+		assert.Equal(t, 1, stats.Size)
+		assert.InDelta(t, 0.5, stats.HitRatio(), 0.001)
+	})
+
+	t.Run("ExpiresByTTL", func(t *testing.T) {
+		c := NewMemoryCache(10)
+		c.Set("a", 1, time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+
+		_, ok := c.Get("a")
+		assert.False(t, ok, "expected expired entry")
+		assert.Equal(t, 0, c.Stats().Size)
+	})
+
+	t.Run("EvictsLeastRecentlyUsed", func(t *testing.T) {
+		c := NewMemoryCache(2)
+		c.Set("a", 1, time.Minute)
+		c.Set("b", 2, time.Minute)
+		c.Get("a")
+		c.Set("c", 3, time.Minute)
+
+		_, ok := c.Get("b")
+		assert.False(t, ok, "expected least recently used entry to be evicted")
+		_, ok = c.Get("a")
+		assert.True(t, ok)
+		_, ok = c.Get("c")
+		assert.True(t, ok)
+	})
+
+	t.Run("DeletePrefix", func(t *testing.T) {
+		c := NewMemoryCache(10)
+		c.Set("news:list:1", 1, time.Minute)
+		c.Set("news:count", 2, time.Minute)
+		c.Set("tags", 3, time.Minute)
+
+		c.DeletePrefix("news:")
+
+		assert.Equal(t, 1, c.Stats().Size)
+		_, ok := c.Get("tags")
+		assert.True(t, ok)
+	})
+}
+
+func TestCached_DeduplicatesLoads(t *testing.T) {
+	u := &Manager{cache: NewMemoryCache(10), loads: &cacheLoads{}}
+
+	var (
+		calls   atomic.Int32
+		wg      sync.WaitGroup
+		release = make(chan struct{})
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cached(context.Background(), u, "key", time.Minute, func(context.Context) (int, error) {
+				calls.Add(1)
+				<-release
+				return 42, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, 42, v)
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load(), "expected single load")
+}
+
+func TestCached_CanceledCaller(t *testing.T) {
+	u := &Manager{cache: NewMemoryCache(10), loads: &cacheLoads{}}
+
+	var (
+		release = make(chan struct{})
+		started = make(chan struct{})
+	)
+	load := func(ctx context.Context) (int, error) {
+		close(started)
+		<-release
+		return 42, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := cached(ctx, u, "key", time.Minute, load)
+		first <- err
+	}()
+	<-started
+
+	second := make(chan int)
+	go func() {
+		v, err := cached(context.Background(), u, "key", time.Minute, func(context.Context) (int, error) { return 0, nil })
+		assert.NoError(t, err)
+		second <- v
+	}()
+
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+	assert.Equal(t, 42, <-second, "expected value loaded by canceled caller")
+}
+
+func TestCached_InvalidatedDuringLoad(t *testing.T) {
+	u := &Manager{cache: NewMemoryCache(10), loads: &cacheLoads{}}
+
+	var (
+		release = make(chan struct{})
+		started = make(chan struct{})
+	)
+	stale := make(chan int)
+	go func() {
+		v, err := cached(context.Background(), u, "news:key", time.Minute, func(context.Context) (int, error) {
+			close(started)
+			<-release
+			return 1, nil
+		})
+		assert.NoError(t, err)
+		stale <- v
+	}()
+	<-started
+
+	u.invalidateNews()
+
+	v, err := cached(context.Background(), u, "news:key", time.Minute, func(context.Context) (int, error) { return 2, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 2, v, "expected new caller not to wait for load started before invalidation")
+
+	close(release)
+	assert.Equal(t, 1, <-stale)
+
+	v, err = cached(context.Background(), u, "news:key", time.Minute, func(context.Context) (int, error) { return 3, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 2, v, "expected stale value not to replace value loaded after invalidation")
+}
+
+func TestCached_InTransaction(t *testing.T) {
+	u := &Manager{cache: NewMemoryCache(10), loads: &cacheLoads{}, inTx: true}
+	u.cache.Set("key", 1, time.Minute)
+
+	v, err := cached(context.Background(), u, "key", time.Minute, func(context.Context) (int, error) { return 2, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 2, v, "expected cache bypass")
+}
+
+func TestManager_Cache_Integration(t *testing.T) {
+	_, ctx, manager := withTx(t)
+	manager.WithCache(NewMemoryCache(100), CacheConfig{
+		CategoriesTTL: time.Minute,
+		TagsTTL:       time.Minute,
+		NewsTTL:       time.Minute,
+	})
+
+	t.Run("CachesCategories", func(t *testing.T) {
+		first, err := manager.Categories(ctx)
+		require.NoError(t, err)
+		before := manager.CacheStats()
+
+		second, err := manager.Categories(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Equal(t, before.Hits+1, manager.CacheStats().Hits, "expected cache hit")
+	})
+
+	t.Run("InvalidatesCategoriesOnWrite", func(t *testing.T) {
+		_, err := manager.Categories(ctx)
+		require.NoError(t, err)
+
+		created, err := manager.CreateCategory(ctx, Category{Category: db.Category{
+			Title:       "Cached category",
+			OrderNumber: 100,
+			StatusID:    db.StatusEnabled,
+		}})
+		require.NoError(t, err)
+
+		categories, err := manager.Categories(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, Categories(categories).IDs(), created.ID, "expected new category after invalidation")
+	})
+
+	t.Run("InvalidatesNewsOnWrite", func(t *testing.T) {
+		count, err := manager.NewsCount(ctx, nil, nil)
+		require.NoError(t, err)
+
+		_, err = manager.CreateNews(ctx, News{News: db.News{
+			CategoryID:  1,
+			Title:       "Cached news",
+			Author:      "Editor",
+			PublishedAt: db.BaseTime,
+			StatusID:    db.StatusPublished,
+		}})
+		require.NoError(t, err)
+
+		newCount, err := manager.NewsCount(ctx, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, count+1, newCount, "expected news count after invalidation")
+	})
+
+	t.Run("StatsWithoutCache", func(t *testing.T) {
+		assert.Equal(t, CacheStats{}, NewNewsManager(testDB).CacheStats())
+	})
+}
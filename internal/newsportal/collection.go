@@ -6,6 +6,8 @@ package newsportal
 //colgen:Category:Map(db)
 //colgen:Tag:Map(db),Index(ID)
 
+import "time"
+
 func (ll NewsList) SetTags(tags Tags) {
 	tagIndex := tags.IndexByID()
 	for i := range ll {
@@ -15,3 +17,18 @@ func (ll NewsList) SetTags(tags Tags) {
 		}
 	}
 }
+
+// LastModified returns the newest publishedAt or updatedAt of news. Returns zero time for empty list.
+func (ll NewsList) LastModified() time.Time {
+	var r time.Time
+	for i := range ll {
+		if ll[i].PublishedAt.After(r) {
+			r = ll[i].PublishedAt
+		}
+		if ll[i].UpdatedAt != nil && ll[i].UpdatedAt.After(r) {
+			r = *ll[i].UpdatedAt
+		}
+	}
+
+	return r
+}
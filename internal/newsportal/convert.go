@@ -59,7 +59,16 @@ func (u *Manager) fillTags(ctx context.Context, news NewsList) error {
 		return nil
 	}
 
-	tags, err := u.TagsByIds(ctx, allTagIDs)
+	// cached list of all enabled tags is cheaper than query by ids
+	var (
+		tags []Tag
+		err  error
+	)
+	if u.cache != nil && u.cacheConfig.TagsTTL > 0 {
+		tags, err = u.Tags(ctx)
+	} else {
+		tags, err = u.TagsByIds(ctx, allTagIDs)
+	}
 	if err != nil {
 		return fmt.Errorf("get tags by ids: %w", err)
 	}
@@ -68,3 +77,27 @@ func (u *Manager) fillTags(ctx context.Context, news NewsList) error {
 
 	return nil
 }
+
+func (u *Manager) fillSnippets(ctx context.Context, news NewsList, query string) error {
+	if len(news) == 0 {
+		return nil
+	}
+
+	headlines, err := u.repo.NewsHeadlines(ctx, u.searchConfig.Config, query, news.IDs(), db.HeadlineOptions{
+		StartSel: u.searchConfig.StartSel,
+		StopSel:  u.searchConfig.StopSel,
+		MaxWords: u.searchConfig.MaxWords,
+		MinWords: u.searchConfig.MinWords,
+	})
+	if err != nil {
+		return fmt.Errorf("get news headlines: %w", err)
+	}
+
+	for i := range news {
+		if headline, ok := headlines[news[i].ID]; ok {
+			news[i].Snippet = &headline
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package newsportal
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+)
+
+// Cursor is a keyset position in news sorted by publishedAt DESC, newsId DESC.
+type Cursor struct {
+	PublishedAt time.Time
+	NewsID      int
+}
+
+// NewCursor returns cursor positioned after news.
+func NewCursor(n News) Cursor {
+	return Cursor{PublishedAt: n.PublishedAt, NewsID: n.ID}
+}
+
+// String returns opaque cursor representation.
+func (c Cursor) String() string {
+	raw := c.PublishedAt.UTC().Format(time.RFC3339Nano) + "," + strconv.Itoa(c.NewsID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor parses cursor returned by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("decode cursor: %w", err)
+	}
+
+	publishedAt, id, ok := strings.Cut(string(raw), ",")
+	if !ok {
+		return Cursor{}, errors.New("invalid cursor format")
+	}
+
+	var c Cursor
+	if c.PublishedAt, err = time.Parse(time.RFC3339Nano, publishedAt); err != nil {
+		return Cursor{}, fmt.Errorf("parse cursor publishedAt: %w", err)
+	}
+	if c.NewsID, err = strconv.Atoi(id); err != nil || c.NewsID <= 0 {
+		return Cursor{}, errors.New("invalid cursor newsId")
+	}
+
+	return c, nil
+}
+
+// NewsByCursor retrieves news with optional filtering by tagID and categoryID after cursor position.
+// Empty cursor starts from the latest news. Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC
+// and cursor of the next page, which is empty on the last page.
+func (u *Manager) NewsByCursor(ctx context.Context, tagID, categoryID *int, cursor string, pageSize *int) ([]News, string, error) {
+	_, ps, err := validatePagination(nil, pageSize)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	ops := []db.OpFunc{
+		db.WithRelations(db.Columns.News.Category),
+		db.WithSort(newsSort...),
+	}
+
+	if cursor != "" {
+		c, err := ParseCursor(cursor)
+		if err != nil {
+			return nil, "", ValidationError{Fields: map[string]string{"cursor": db.ErrWrongValue}}
+		}
+		ops = append(ops, db.WithNewsSeek(c.PublishedAt, c.NewsID))
+	}
+
+	// load one extra news to detect next page
+	dbNews, err := u.repo.NewsByFilters(ctx, publishedNewsSearch(tagID, categoryID), db.NewPager(1, ps+1), ops...)
+	if err != nil {
+		return nil, "", fmt.Errorf("db get news by cursor: %w", err)
+	}
+
+	var next string
+	if len(dbNews) > ps {
+		dbNews = dbNews[:ps]
+		next = NewCursor(NewNews(dbNews[ps-1])).String()
+	}
+
+	newsList := NewNewsList(dbNews)
+
+	err = u.fillTags(ctx, newsList)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to attach tags to news: %w", err)
+	}
+
+	return newsList, next, nil
+}
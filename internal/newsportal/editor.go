@@ -0,0 +1,543 @@
+package newsportal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// ValidationError contains field errors keyed by db column name.
+type ValidationError struct {
+	Fields map[string]string
+}
+
+func (e ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field, reason := range e.Fields {
+		fields = append(fields, field+": "+reason)
+	}
+	sort.Strings(fields)
+
+	return "validation failed: " + strings.Join(fields, ", ")
+}
+
+// CreateNews validates and adds news with the first revision. News without status are created as drafts,
+// published news with publishedAt in the future are scheduled. Only admin can create scheduled and published news,
+// ErrForbidden is returned for other roles. Returns created news with category and tags.
+// Published news are sent to webhooks and news stream as EventNewsPublished.
+func (u *Manager) CreateNews(ctx context.Context, news News) (*News, error) {
+	dbNews := news.News
+	dbNews.ID = 0
+	dbNews.UpdatedAt = nil
+	dbNews.StatusID = int(newsState(dbNews, time.Now()))
+
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		if err := m.validateNews(ctx, dbNews); err != nil {
+			return err
+		} else if err := validateInitialState(ctx, State(dbNews.StatusID)); err != nil {
+			return err
+		}
+
+		if _, err := m.editRepo.AddNews(ctx, &dbNews); err != nil {
+			return fmt.Errorf("db add news: %w", err)
+		}
+
+		if err := m.addNewsRevision(ctx, dbNews); err != nil {
+			return err
+		}
+
+		if err := m.recordNewsEvent(ctx, StateDraft, dbNews); err != nil {
+			return err
+		}
+
+		return m.recordAudit(ctx, AuditEntityNews, dbNews.ID, AuditActionCreate, nil, dbNews)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateNews()
+
+	created, err := u.NewsForEdit(ctx, dbNews.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateNews validates and updates news, saved news is added as a new revision. News without status keep
+// the current state, state change must be an allowed transition, only admin can schedule and publish news.
+// Changes of public news are sent to webhooks and news stream. Returns ErrNotFound for unknown or deleted news,
+// ErrInvalidTransition for not allowed state change and ErrForbidden if principal role is not enough.
+func (u *Manager) UpdateNews(ctx context.Context, news News) (*News, error) {
+	dbNews := news.News
+	now := time.Now()
+	dbNews.UpdatedAt = &now
+
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.editRepo.NewsByID(ctx, news.ID)
+		if err != nil {
+			return fmt.Errorf("db get news by id: %w", err)
+		} else if existing == nil {
+			return ErrNotFound
+		}
+
+		if dbNews.StatusID == 0 {
+			dbNews.StatusID = existing.StatusID
+		}
+		dbNews.StatusID = int(newsState(dbNews, now))
+
+		if err := m.validateNews(ctx, dbNews); err != nil {
+			return err
+		}
+
+		from := State(existing.StatusID)
+		if to := State(dbNews.StatusID); from != to {
+			if err := checkTransition(ctx, from, to); err != nil {
+				return err
+			}
+		}
+
+		if _, err := m.editRepo.UpdateNews(ctx, &dbNews); err != nil {
+			return fmt.Errorf("db update news: %w", err)
+		}
+
+		if err := m.addNewsRevision(ctx, dbNews); err != nil {
+			return err
+		}
+
+		if err := m.recordNewsEvent(ctx, from, dbNews); err != nil {
+			return err
+		}
+
+		return m.recordAudit(ctx, AuditEntityNews, dbNews.ID, AuditActionUpdate, existing, dbNews)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateNews()
+
+	updated, err := u.NewsForEdit(ctx, dbNews.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// PatchNews locks news, applies patch and updates news as UpdateNews in one transaction,
+// so concurrent patches of different fields are not lost. Returns errors of UpdateNews.
+func (u *Manager) PatchNews(ctx context.Context, newsID int, patch func(news *News)) (*News, error) {
+	var updated *News
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		locked, err := m.editRepo.NewsByID(ctx, newsID, db.ForUpdate(db.Tables.News.Alias))
+		if err != nil {
+			return fmt.Errorf("db lock news: %w", err)
+		} else if locked == nil {
+			return ErrNotFound
+		}
+
+		news, err := m.NewsForEdit(ctx, newsID)
+		if err != nil {
+			return err
+		}
+
+		patch(news)
+		news.ID = newsID
+
+		updated, err = m.UpdateNews(ctx, *news)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// cache is invalidated again after commit, values loaded before it may be stale
+	u.invalidateNews()
+
+	return updated, nil
+}
+
+// DeleteNews marks news as deleted, deleted public news are sent to webhooks as EventNewsDeleted.
+// Returns ErrNotFound for unknown or already deleted news.
+func (u *Manager) DeleteNews(ctx context.Context, newsID int) error {
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		dbNews, err := m.editRepo.NewsByID(ctx, newsID)
+		if err != nil {
+			return fmt.Errorf("db get news by id: %w", err)
+		} else if dbNews == nil {
+			return ErrNotFound
+		}
+
+		if _, err := m.editRepo.DeleteNews(ctx, newsID); err != nil {
+			return fmt.Errorf("db delete news: %w", err)
+		}
+
+		existing, deleted := *dbNews, *dbNews
+		deleted.StatusID = db.StatusDeleted
+
+		if err := m.recordNewsEvent(ctx, State(existing.StatusID), deleted); err != nil {
+			return err
+		}
+
+		return m.recordAudit(ctx, AuditEntityNews, newsID, AuditActionDelete, existing, deleted)
+	})
+	if err != nil {
+		return err
+	}
+	u.invalidateNews()
+
+	return nil
+}
+
+// validateNews checks news fields, state, category and tags. Category and tags must exist and be enabled.
+func (u *Manager) validateNews(ctx context.Context, news db.News) error {
+	fields, _ := news.Validate()
+	validateState(fields, State(news.StatusID), news.PublishedAt, time.Now())
+
+	if strings.TrimSpace(news.Title) == "" {
+		fields[db.Columns.News.Title] = db.ErrEmptyValue
+	}
+
+	if strings.TrimSpace(news.Author) == "" {
+		fields[db.Columns.News.Author] = db.ErrEmptyValue
+	}
+
+	if news.PublishedAt.IsZero() {
+		fields[db.Columns.News.PublishedAt] = db.ErrEmptyValue
+	}
+
+	category, err := u.repo.CategoryByID(ctx, news.CategoryID)
+	if err != nil {
+		return fmt.Errorf("db get category by id: %w", err)
+	} else if category == nil {
+		fields[db.Columns.News.CategoryID] = db.ErrWrongValue
+	}
+
+	tagIDs := uniqueInts(news.TagIDs)
+	if len(tagIDs) > 0 {
+		count, err := u.repo.CountTags(ctx, &db.TagSearch{IDs: tagIDs})
+		if err != nil {
+			return fmt.Errorf("db count tags: %w", err)
+		} else if count != len(tagIDs) {
+			fields[db.Columns.News.TagIDs] = db.ErrWrongValue
+		}
+	}
+
+	if len(fields) > 0 {
+		return ValidationError{Fields: fields}
+	}
+
+	return nil
+}
+
+// NewsForEdit returns news regardless of publication state, with category and tags.
+// Returns ErrNotFound for unknown or deleted news.
+func (u *Manager) NewsForEdit(ctx context.Context, newsID int) (*News, error) {
+	dbNews, err := u.editRepo.NewsByID(ctx, newsID, db.WithRelations(db.Columns.News.Category))
+	if err != nil {
+		return nil, fmt.Errorf("db get news by id: %w", err)
+	} else if dbNews == nil {
+		return nil, ErrNotFound
+	}
+
+	newsList := NewNewsList([]db.News{*dbNews})
+
+	err = u.fillTags(ctx, newsList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach tags to news: %w", err)
+	}
+
+	return &newsList[0], nil
+}
+
+// CategoryForEdit returns enabled or disabled category. Returns ErrNotFound for unknown or deleted category.
+func (u *Manager) CategoryForEdit(ctx context.Context, categoryID int) (*Category, error) {
+	dbCategory, err := u.editRepo.CategoryByID(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("db get category by id: %w", err)
+	} else if dbCategory == nil {
+		return nil, ErrNotFound
+	}
+
+	category := NewCategory(*dbCategory)
+	return &category, nil
+}
+
+// CreateCategory validates and adds category.
+func (u *Manager) CreateCategory(ctx context.Context, category Category) (*Category, error) {
+	dbCategory := category.Category
+	dbCategory.ID = 0
+
+	if err := validateCategory(dbCategory); err != nil {
+		return nil, err
+	}
+
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		if _, err := m.editRepo.AddCategory(ctx, &dbCategory); err != nil {
+			return fmt.Errorf("db add category: %w", err)
+		}
+
+		return m.recordAudit(ctx, AuditEntityCategory, dbCategory.ID, AuditActionCreate, nil, dbCategory)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateCategories()
+
+	return u.CategoryForEdit(ctx, dbCategory.ID)
+}
+
+// UpdateCategory validates and updates category. Returns ErrNotFound for unknown or deleted category.
+func (u *Manager) UpdateCategory(ctx context.Context, category Category) (*Category, error) {
+	dbCategory := category.Category
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.CategoryForEdit(ctx, category.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := validateCategory(dbCategory); err != nil {
+			return err
+		}
+
+		if _, err := m.editRepo.UpdateCategory(ctx, &dbCategory); err != nil {
+			return fmt.Errorf("db update category: %w", err)
+		}
+
+		return m.recordAudit(ctx, AuditEntityCategory, dbCategory.ID, AuditActionUpdate, existing.Category, dbCategory)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateCategories()
+
+	return u.CategoryForEdit(ctx, dbCategory.ID)
+}
+
+// PatchCategory locks category, applies patch and updates category as UpdateCategory in one transaction.
+func (u *Manager) PatchCategory(ctx context.Context, categoryID int, patch func(category *Category)) (*Category, error) {
+	var updated *Category
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		dbCategory, err := m.editRepo.CategoryByID(ctx, categoryID, db.ForUpdate(db.Tables.Category.Alias))
+		if err != nil {
+			return fmt.Errorf("db lock category: %w", err)
+		} else if dbCategory == nil {
+			return ErrNotFound
+		}
+
+		category := NewCategory(*dbCategory)
+		patch(&category)
+		category.ID = categoryID
+
+		updated, err = m.UpdateCategory(ctx, category)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateCategories()
+
+	return updated, nil
+}
+
+// DeleteCategory marks category as deleted. Returns ErrNotFound for unknown or already deleted category.
+func (u *Manager) DeleteCategory(ctx context.Context, categoryID int) error {
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.CategoryForEdit(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := m.editRepo.DeleteCategory(ctx, categoryID); err != nil {
+			return fmt.Errorf("db delete category: %w", err)
+		}
+
+		deleted := existing.Category
+		deleted.StatusID = db.StatusDeleted
+
+		return m.recordAudit(ctx, AuditEntityCategory, categoryID, AuditActionDelete, existing.Category, deleted)
+	})
+	if err != nil {
+		return err
+	}
+	u.invalidateCategories()
+
+	return nil
+}
+
+// TagForEdit returns enabled or disabled tag. Returns ErrNotFound for unknown or deleted tag.
+func (u *Manager) TagForEdit(ctx context.Context, tagID int) (*Tag, error) {
+	dbTag, err := u.editRepo.TagByID(ctx, tagID)
+	if err != nil {
+		return nil, fmt.Errorf("db get tag by id: %w", err)
+	} else if dbTag == nil {
+		return nil, ErrNotFound
+	}
+
+	tag := NewTag(*dbTag)
+	return &tag, nil
+}
+
+// CreateTag validates and adds tag.
+func (u *Manager) CreateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	dbTag := tag.Tag
+	dbTag.ID = 0
+
+	if err := validateTag(dbTag); err != nil {
+		return nil, err
+	}
+
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		if _, err := m.editRepo.AddTag(ctx, &dbTag); err != nil {
+			return fmt.Errorf("db add tag: %w", err)
+		}
+
+		return m.recordAudit(ctx, AuditEntityTag, dbTag.ID, AuditActionCreate, nil, dbTag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateTags()
+
+	return u.TagForEdit(ctx, dbTag.ID)
+}
+
+// UpdateTag validates and updates tag. Returns ErrNotFound for unknown or deleted tag.
+func (u *Manager) UpdateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	dbTag := tag.Tag
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.TagForEdit(ctx, tag.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := validateTag(dbTag); err != nil {
+			return err
+		}
+
+		if _, err := m.editRepo.UpdateTag(ctx, &dbTag); err != nil {
+			return fmt.Errorf("db update tag: %w", err)
+		}
+
+		return m.recordAudit(ctx, AuditEntityTag, dbTag.ID, AuditActionUpdate, existing.Tag, dbTag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateTags()
+
+	return u.TagForEdit(ctx, dbTag.ID)
+}
+
+// PatchTag locks tag, applies patch and updates tag as UpdateTag in one transaction.
+func (u *Manager) PatchTag(ctx context.Context, tagID int, patch func(tag *Tag)) (*Tag, error) {
+	var updated *Tag
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		dbTag, err := m.editRepo.TagByID(ctx, tagID, db.ForUpdate(db.Tables.Tag.Alias))
+		if err != nil {
+			return fmt.Errorf("db lock tag: %w", err)
+		} else if dbTag == nil {
+			return ErrNotFound
+		}
+
+		tag := NewTag(*dbTag)
+		patch(&tag)
+		tag.ID = tagID
+
+		updated, err = m.UpdateTag(ctx, tag)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateTags()
+
+	return updated, nil
+}
+
+// DeleteTag marks tag as deleted. Returns ErrNotFound for unknown or already deleted tag.
+func (u *Manager) DeleteTag(ctx context.Context, tagID int) error {
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.TagForEdit(ctx, tagID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := m.editRepo.DeleteTag(ctx, tagID); err != nil {
+			return fmt.Errorf("db delete tag: %w", err)
+		}
+
+		deleted := existing.Tag
+		deleted.StatusID = db.StatusDeleted
+
+		return m.recordAudit(ctx, AuditEntityTag, tagID, AuditActionDelete, existing.Tag, deleted)
+	})
+	if err != nil {
+		return err
+	}
+	u.invalidateTags()
+
+	return nil
+}
+
+func validateCategory(category db.Category) error {
+	fields, _ := category.Validate()
+
+	if strings.TrimSpace(category.Title) == "" {
+		fields[db.Columns.Category.Title] = db.ErrEmptyValue
+	}
+
+	if !isEditableStatus(category.StatusID) {
+		fields[db.Columns.Category.StatusID] = db.ErrWrongValue
+	}
+
+	if len(fields) > 0 {
+		return ValidationError{Fields: fields}
+	}
+
+	return nil
+}
+
+func validateTag(tag db.Tag) error {
+	fields, _ := tag.Validate()
+
+	if strings.TrimSpace(tag.Title) == "" {
+		fields[db.Columns.Tag.Title] = db.ErrEmptyValue
+	}
+
+	if !isEditableStatus(tag.StatusID) {
+		fields[db.Columns.Tag.StatusID] = db.ErrWrongValue
+	}
+
+	if len(fields) > 0 {
+		return ValidationError{Fields: fields}
+	}
+
+	return nil
+}
+
+// isEditableStatus reports whether status can be set by editors. Deleted status is set by Delete* methods only.
+func isEditableStatus(statusID int) bool {
+	return statusID == db.StatusEnabled || statusID == db.StatusDisabled
+}
+
+func uniqueInts(in []int) []int {
+	idx := make(map[int]struct{}, len(in))
+	r := make([]int, 0, len(in))
+	for _, v := range in {
+		if _, ok := idx[v]; !ok {
+			idx[v] = struct{}{}
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
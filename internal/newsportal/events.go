@@ -0,0 +1,67 @@
+package newsportal
+
+import (
+	"context"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+)
+
+// EventType is a type of news domain event.
+type EventType string
+
+const (
+	// EventNewsPublished is recorded when news becomes public: it is created or updated as published,
+	// moved to published state or published by scheduler.
+	EventNewsPublished EventType = "news.published"
+	// EventNewsUpdated is recorded when public news is updated.
+	EventNewsUpdated EventType = "news.updated"
+	// EventNewsDeleted is recorded when public news is removed from the portal: deleted, archived or unpublished.
+	EventNewsDeleted EventType = "news.deleted"
+)
+
+// EventTypes are known event types.
+var EventTypes = []EventType{EventNewsPublished, EventNewsUpdated, EventNewsDeleted}
+
+// Valid reports whether event type is known.
+func (t EventType) Valid() bool {
+	for _, et := range EventTypes {
+		if t == et {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newsEventType returns type of event of news moved between states. Only changes of public news produce events.
+func newsEventType(from, to State) (EventType, bool) {
+	switch {
+	case !from.Public() && to.Public():
+		return EventNewsPublished, true
+	case from.Public() && to.Public():
+		return EventNewsUpdated, true
+	case from.Public() && !to.Public():
+		return EventNewsDeleted, true
+	}
+
+	return "", false
+}
+
+// Event is a news domain event received by NewsStream subscribers.
+type Event struct {
+	Type      EventType
+	News      News
+	CreatedAt time.Time
+}
+
+// recordNewsEvent records event of news moved from state in transaction of news change: webhook deliveries
+// are saved to outbox and news stream is notified by pg_notify, so events are delivered only after commit.
+// It is the only place where domain events are produced.
+func (u *Manager) recordNewsEvent(ctx context.Context, from State, news db.News) error {
+	if err := u.enqueueWebhooks(ctx, from, news); err != nil {
+		return err
+	}
+
+	return u.notifyNewsEvent(ctx, from, news)
+}
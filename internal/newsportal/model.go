@@ -1,6 +1,8 @@
 package newsportal
 
 import (
+	"strings"
+
 	"github.com/daniilsolovey/news-portal/internal/db"
 )
 
@@ -18,10 +20,57 @@ type News struct {
 	db.News
 	Category Category
 	Tags     []Tag
+	// Snippet is a content fragment with highlighted search terms, set by SearchNews only.
+	Snippet *string
+}
+
+// NewsPage is a news page with total count of news matching filter.
+type NewsPage struct {
+	Items    []News
+	Total    int
+	Page     int
+	PageSize int
+	HasNext  bool
+}
+
+// State returns news workflow state.
+func (n News) State() State {
+	return State(n.StatusID)
+}
+
+// Excerpt returns plain content shortened to maxLen runes at a word boundary, with ellipsis if cut.
+func (n News) Excerpt(maxLen int) string {
+	if n.Content == nil {
+		return ""
+	}
+
+	content := strings.Join(strings.Fields(*n.Content), " ")
+	runes := []rune(content)
+	if len(runes) <= maxLen {
+		return content
+	}
+
+	cut := string(runes[:maxLen])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+
+	return cut + "…"
+}
+
+// SearchConfig configures full-text search and snippets.
+type SearchConfig struct {
+	// Config is a text search configuration: russian or english.
+	Config string
+	// StartSel and StopSel wrap matched terms in snippets.
+	StartSel string
+	StopSel  string
+	// MaxWords and MinWords limit snippet length.
+	MaxWords int
+	MinWords int
 }
 
 type NewsFilter struct {
 	TagID      *int
 	CategoryID *int
 }
-
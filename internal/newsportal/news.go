@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10"
 	"github.com/go-pg/pg/v10/orm"
 )
 
@@ -14,43 +16,174 @@ const (
 	defaultPage     = 1
 	defaultPageSize = 10
 	maxPageSize     = 100
-	StatusPublished = 1
 )
 
+// newsSort is a stable news order: publishedAt DESC, newsId DESC.
+var newsSort = []db.SortField{
+	db.NewSortField(db.Columns.News.PublishedAt, true),
+	db.NewSortField(db.Columns.News.ID, true),
+}
+
 type Manager struct {
-	repo db.NewsRepo
+	dbc          orm.DB
+	repo         db.NewsRepo
+	editRepo     db.NewsRepo
+	webhookRepo  db.WebhookRepo
+	searchConfig SearchConfig
+
+	cache       Cache
+	cacheConfig CacheConfig
+	loads       *cacheLoads
+	// inTx is set for managers bound to transaction by inTransaction, they bypass cache
+	inTx bool
 }
 
 func NewNewsManager(dbc orm.DB) *Manager {
 	return &Manager{
-		repo: db.NewNewsRepo(dbc).WithEnabledOnly(),
+		dbc:         dbc,
+		repo:        db.NewNewsRepo(dbc).WithEnabledOnly(),
+		editRepo:    db.NewNewsRepo(dbc),
+		webhookRepo: db.NewWebhookRepo(dbc).WithEnabledOnly(),
+		searchConfig: SearchConfig{
+			Config:   db.TextSearchRussian,
+			StartSel: "<b>",
+			StopSel:  "</b>",
+			MaxWords: 35,
+			MinWords: 15,
+		},
 	}
 }
 
-// NewsByFilter retrieves news with optional filtering by tagID and categoryID, with pagination
-// Returns NewsSummary (without content) sorted by publishedAt DESC
-func (u *Manager) NewsByFilter(ctx context.Context, tagID, categoryID *int, page, pageSize *int) ([]News, error) {
-	p, ps, err := validatePagination(page, pageSize)
-	if err != nil {
-		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+// WithSearchConfig sets full-text search configuration for SearchNews. Empty fields are ignored.
+func (u *Manager) WithSearchConfig(cfg SearchConfig) *Manager {
+	if cfg.Config != "" {
+		u.searchConfig.Config = cfg.Config
+	}
+	if cfg.StartSel != "" || cfg.StopSel != "" {
+		u.searchConfig.StartSel, u.searchConfig.StopSel = cfg.StartSel, cfg.StopSel
+	}
+	if cfg.MaxWords > 0 {
+		u.searchConfig.MaxWords = cfg.MaxWords
+	}
+	if cfg.MinWords > 0 {
+		u.searchConfig.MinWords = cfg.MinWords
 	}
 
-	status := StatusPublished
+	return u
+}
+
+// WithCache sets cache for categories, tags and news lists with TTLs from cfg. Nil cache disables caching.
+func (u *Manager) WithCache(cache Cache, cfg CacheConfig) *Manager {
+	u.cache = cache
+	u.cacheConfig = cfg
+	u.loads = &cacheLoads{}
+
+	return u
+}
+
+// CacheStats returns cache hit and miss counters, or zero stats without cache.
+func (u *Manager) CacheStats() CacheStats {
+	if u.cache == nil {
+		return CacheStats{}
+	}
+
+	return u.cache.Stats()
+}
+
+// publishedNewsSearch returns search for news visible on the portal:
+// news in public state with enabled category and publishedAt in the past.
+func publishedNewsSearch(tagID, categoryID *int) *db.NewsSearch {
+	status := db.StatusEnabled
 	now := time.Now()
 
-	dbNews, err := u.repo.NewsByFilters(ctx, &db.NewsSearch{
+	return &db.NewsSearch{
 		CategoryID:     categoryID,
 		CategoryStatus: &status,
 		Tag:            tagID,
 		PublishedAtLE:  &now,
-	},
+		StatusIDs:      publicStatusIDs(),
+	}
+}
+
+// NewsByFilter retrieves news with optional filtering by tagID and categoryID, with pagination
+// Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC
+func (u *Manager) NewsByFilter(ctx context.Context, tagID, categoryID *int, page, pageSize *int) ([]News, error) {
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	return cached(ctx, u, newsCacheKey("list", tagID, categoryID, &p, &ps), u.cacheConfig.NewsTTL, func(ctx context.Context) ([]News, error) {
+		dbNews, err := u.repo.NewsByFilters(ctx, publishedNewsSearch(tagID, categoryID),
+			db.NewPager(p, ps),
+			db.WithRelations(db.Columns.News.Category),
+			db.WithSort(newsSort...),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("db get news by filters: %w", err)
+		}
+
+		newsList := NewNewsList(dbNews)
+
+		err = u.fillTags(ctx, newsList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach tags to news: %w", err)
+		}
+
+		return newsList, nil
+	})
+}
+
+// NewsPage retrieves news page as NewsByFilter with total count of news from NewsCount.
+// List and count are loaded in one repeatable read transaction bypassing cache, so total matches the list.
+func (u *Manager) NewsPage(ctx context.Context, tagID, categoryID *int, page, pageSize *int) (*NewsPage, error) {
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	// page is cached as one entry, so items and total are never taken from different snapshots
+	return cached(ctx, u, newsCacheKey("page", tagID, categoryID, &p, &ps), u.cacheConfig.NewsTTL, func(ctx context.Context) (*NewsPage, error) {
+		r := &NewsPage{Page: p, PageSize: ps}
+		err := u.inTransaction(ctx, isolationRepeatableRead, func(m *Manager, _ *pg.Tx) (err error) {
+			if r.Items, err = m.NewsByFilter(ctx, tagID, categoryID, &p, &ps); err != nil {
+				return err
+			}
+
+			r.Total, err = m.NewsCount(ctx, tagID, categoryID)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		r.HasNext = p*ps < r.Total
+
+		return r, nil
+	})
+}
+
+// SearchNews retrieves visible news matching full-text query with optional filtering by tagID and categoryID.
+// Results are ranked by ts_rank, then sorted by publishedAt DESC, newsId DESC, so pages are stable. Each news has a content snippet with highlighted terms.
+func (u *Manager) SearchNews(ctx context.Context, query string, tagID, categoryID *int, page, pageSize *int) ([]News, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, ValidationError{Fields: map[string]string{"query": db.ErrEmptyValue}}
+	}
+
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	dbNews, err := u.repo.NewsByFilters(ctx, publishedNewsSearch(tagID, categoryID),
 		db.NewPager(p, ps),
 		db.WithRelations(db.Columns.News.Category),
-		db.WithSort(db.NewSortField(db.Columns.News.PublishedAt, true)),
+		db.WithTextSearch(u.searchConfig.Config, query),
+		db.WithSort(newsSort...),
 	)
-
 	if err != nil {
-		return nil, fmt.Errorf("db get news by filters: %w", err)
+		return nil, fmt.Errorf("db search news: %w", err)
 	}
 
 	newsList := NewNewsList(dbNews)
@@ -60,37 +193,38 @@ func (u *Manager) NewsByFilter(ctx context.Context, tagID, categoryID *int, page
 		return nil, fmt.Errorf("failed to attach tags to news: %w", err)
 	}
 
+	err = u.fillSnippets(ctx, newsList, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach snippets to news: %w", err)
+	}
+
 	return newsList, nil
 }
 
 func (u *Manager) NewsCount(ctx context.Context, tagID, categoryID *int) (int, error) {
-	status := StatusPublished
-	now := time.Now()
-	count, err := u.repo.CountNews(ctx, &db.NewsSearch{
-		CategoryID:     categoryID,
-		CategoryStatus: &status,
-		Tag:            tagID,
-		PublishedAtLE:  &now,
-	},
-		db.WithRelations(db.Columns.News.Category),
-	)
-	if err != nil {
-		return 0, fmt.Errorf("db get news count: %w", err)
-	}
+	return cached(ctx, u, newsCacheKey("count", tagID, categoryID), u.cacheConfig.NewsTTL, func(ctx context.Context) (int, error) {
+		count, err := u.repo.CountNews(ctx, publishedNewsSearch(tagID, categoryID),
+			db.WithRelations(db.Columns.News.Category),
+		)
+		if err != nil {
+			return 0, fmt.Errorf("db get news count: %w", err)
+		}
 
-	return count, nil
+		return count, nil
+	})
 }
 
 func (u *Manager) NewsByID(ctx context.Context, newsID int) (*News, error) {
-	status := StatusPublished
-	now := time.Now()
+	return cached(ctx, u, newsCacheKey("id", &newsID), u.cacheConfig.NewsTTL, func(ctx context.Context) (*News, error) {
+		return u.newsByID(ctx, newsID)
+	})
+}
 
-	dbNews, err := u.repo.OneNews(ctx, &db.NewsSearch{
-		ID:             &newsID,
-		CategoryStatus: &status,
-		PublishedAtLE:  &now,
-	},
-		db.WithRelations(db.Columns.News.Category))
+func (u *Manager) newsByID(ctx context.Context, newsID int) (*News, error) {
+	search := publishedNewsSearch(nil, nil)
+	search.ID = &newsID
+
+	dbNews, err := u.repo.OneNews(ctx, search, db.WithRelations(db.Columns.News.Category))
 	if err != nil {
 		return nil, fmt.Errorf("db get news by id: %w", err)
 	} else if dbNews == nil {
@@ -108,17 +242,47 @@ func (u *Manager) NewsByID(ctx context.Context, newsID int) (*News, error) {
 }
 
 func (u *Manager) Categories(ctx context.Context) ([]Category, error) {
-	list, err := u.repo.CategoriesByFilters(ctx, nil, db.PagerNoLimit, db.EnabledOnly())
+	return cached(ctx, u, cacheKeyCategories, u.cacheConfig.CategoriesTTL, func(ctx context.Context) ([]Category, error) {
+		list, err := u.repo.CategoriesByFilters(ctx, nil, db.PagerNoLimit, db.EnabledOnly())
+
+		return NewCategories(list), err
+	})
+}
+
+// CategoryByID returns enabled category or nil.
+func (u *Manager) CategoryByID(ctx context.Context, categoryID int) (*Category, error) {
+	dbCategory, err := u.repo.CategoryByID(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("db get category by id: %w", err)
+	} else if dbCategory == nil {
+		return nil, nil
+	}
 
-	return NewCategories(list), err
+	category := NewCategory(*dbCategory)
+	return &category, nil
 }
 
 func (u *Manager) Tags(ctx context.Context) ([]Tag, error) {
-	list, err := u.repo.TagsByFilters(ctx, nil, db.PagerNoLimit,
-		db.WithSort(db.NewSortField(db.Columns.Tag.Title, false)),
-	)
+	return cached(ctx, u, cacheKeyTags, u.cacheConfig.TagsTTL, func(ctx context.Context) ([]Tag, error) {
+		list, err := u.repo.TagsByFilters(ctx, nil, db.PagerNoLimit,
+			db.WithSort(db.NewSortField(db.Columns.Tag.Title, false)),
+		)
 
-	return NewTags(list), err
+		return NewTags(list), err
+	})
+}
+
+// TagByID returns enabled tag or nil.
+func (u *Manager) TagByID(ctx context.Context, tagID int) (*Tag, error) {
+	dbTag, err := u.repo.TagByID(ctx, tagID)
+	if err != nil {
+		return nil, fmt.Errorf("db get tag by id: %w", err)
+	} else if dbTag == nil {
+		return nil, nil
+	}
+
+	tag := NewTag(*dbTag)
+	return &tag, nil
 }
 
 func (u *Manager) TagsByIds(ctx context.Context, tagIds []int) ([]Tag, error) {
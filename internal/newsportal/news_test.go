@@ -105,7 +105,7 @@ func createTestNews(t *testing.T, tx *pg.Tx, ctx context.Context, opts ...newsOp
 		Author:      "Test Author",
 		PublishedAt: baseTime.Add(-24 * time.Hour),
 		TagIDs:      []int{1},
-		StatusID:    StatusPublished,
+		StatusID:    db.StatusPublished,
 	}
 
 	for _, opt := range opts {
@@ -150,7 +150,7 @@ func createTestCategory(t *testing.T, tx *pg.Tx, ctx context.Context, opts ...ca
 	category := &db.Category{
 		Title:       "Test Category",
 		OrderNumber: 99,
-		StatusID:    StatusPublished,
+		StatusID:    db.StatusEnabled,
 	}
 
 	for _, opt := range opts {
@@ -182,7 +182,7 @@ func createTestTag(t *testing.T, tx *pg.Tx, ctx context.Context, opts ...tagOpti
 
 	tag := &db.Tag{
 		Title:    "Test Tag",
-		StatusID: StatusPublished,
+		StatusID: db.StatusEnabled,
 	}
 
 	for _, opt := range opts {
@@ -300,7 +300,7 @@ func TestManager_NewsByFilter_Integration(t *testing.T) {
 				for _, tag := range item.Tags {
 					assert.NotZero(t, tag.ID, "news %d has tag with zero TagID", item.ID)
 					assert.NotEmpty(t, tag.Title, "news %d has tag with empty Title", item.ID)
-					assert.Equal(t, StatusPublished, tag.StatusID, "news %d has tag %d with invalid StatusID", item.ID, tag.ID)
+					assert.Equal(t, db.StatusEnabled, tag.StatusID, "news %d has tag %d with invalid StatusID", item.ID, tag.ID)
 				}
 			}
 		}
@@ -343,13 +343,13 @@ func TestManager_NewsByFilter_Integration(t *testing.T) {
 
 		for _, item := range allNews {
 			assert.NotEqual(t, newsInUnpublishedCategory.ID, item.ID, "news should not be returned (unpublished category)")
-			assert.Equal(t, StatusPublished, item.Category.StatusID, "returned news %d has category status", item.ID)
+			assert.Equal(t, db.StatusEnabled, item.Category.StatusID, "returned news %d has category status", item.ID)
 		}
 	})
 
 	t.Run("ExcludesNewsWithUnpublishedStatus", func(t *testing.T) {
 		unpublishedNews := createTestNews(t, tx, ctx,
-			withStatusID(2),
+			withStatusID(db.StatusDraft),
 			withTitle("Unpublished News"),
 		)
 
@@ -358,7 +358,7 @@ func TestManager_NewsByFilter_Integration(t *testing.T) {
 
 		for _, item := range allNews {
 			assert.NotEqual(t, unpublishedNews.ID, item.ID, "news should not be returned (unpublished status)")
-			assert.Equal(t, StatusPublished, item.StatusID, "returned news %d has status", item.ID)
+			assert.Equal(t, db.StatusPublished, item.StatusID, "returned news %d has status", item.ID)
 		}
 	})
 
@@ -368,7 +368,7 @@ func TestManager_NewsByFilter_Integration(t *testing.T) {
 		require.NotEmpty(t, allNews, "expected at least one news item, got empty result")
 
 		for _, item := range allNews {
-			assert.Equal(t, StatusPublished, item.StatusID, "returned news %d (title: %q) has status", item.ID, item.Title)
+			assert.Equal(t, db.StatusPublished, item.StatusID, "returned news %d (title: %q) has status", item.ID, item.Title)
 		}
 	})
 
@@ -461,13 +461,13 @@ func TestManager_NewsByID_Integration(t *testing.T) {
 		for _, tag := range news.Tags {
 			assert.NotZero(t, tag.ID, "tag has zero TagID")
 			assert.NotEmpty(t, tag.Title, "tag has empty Title")
-			assert.Equal(t, StatusPublished, tag.StatusID, "tag %d has invalid StatusID", tag.ID)
+			assert.Equal(t, db.StatusEnabled, tag.StatusID, "tag %d has invalid StatusID", tag.ID)
 		}
 	})
 
 	t.Run("WithUnpublishedStatusReturnsNil", func(t *testing.T) {
 		unpublishedNews := createTestNews(t, tx, ctx,
-			withStatusID(2),
+			withStatusID(db.StatusDraft),
 			withTitle("Unpublished News"),
 		)
 
@@ -621,10 +621,10 @@ func assertNewsBasic(t *testing.T, news *News) {
 
 	require.NotZero(t, news.ID, "invalid NewsID")
 	require.NotEmpty(t, news.Title, "empty Title")
-	assert.Equal(t, StatusPublished, news.StatusID, "invalid StatusID")
+	assert.Equal(t, db.StatusPublished, news.StatusID, "invalid StatusID")
 	require.NotZero(t, news.CategoryID, "invalid CategoryID")
 	require.NotZero(t, news.Category.ID, "category not loaded")
-	assert.Equal(t, StatusPublished, news.Category.StatusID, "invalid Category StatusID")
+	assert.Equal(t, db.StatusEnabled, news.Category.StatusID, "invalid Category StatusID")
 	assert.False(t, news.PublishedAt.After(db.BaseTime.Add(365*24*time.Hour)), "publishedAt is unexpectedly in the future: %v", news.PublishedAt)
 }
 
@@ -632,13 +632,13 @@ func assertNewsValid(t *testing.T, news *News, newsID int) {
 	t.Helper()
 	require.NotNil(t, news, "news is nil")
 	assert.Equal(t, newsID, news.ID, "expected NewsID to match")
-	assert.Equal(t, StatusPublished, news.StatusID, "invalid StatusID")
+	assert.Equal(t, db.StatusPublished, news.StatusID, "invalid StatusID")
 	require.NotEmpty(t, news.Title, "empty Title")
 	require.NotEmpty(t, *news.Content, "empty Content")
 	require.NotEmpty(t, news.Author, "empty Author")
 	require.NotZero(t, news.CategoryID, "invalid CategoryID")
 	require.NotZero(t, news.Category.ID, "category not loaded")
-	assert.Equal(t, StatusPublished, news.Category.StatusID, "invalid Category StatusID")
+	assert.Equal(t, db.StatusEnabled, news.Category.StatusID, "invalid Category StatusID")
 }
 
 func assertCategoryValid(t *testing.T, category Category) {
@@ -661,3 +661,399 @@ func assertNewsSortedByPublishedAt(t *testing.T, news []News) {
 		assert.False(t, news[i].PublishedAt.Before(news[i+1].PublishedAt), "news not sorted by publishedAt desc at %d", i)
 	}
 }
+
+func TestManager_CreateNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	newNews := func(opts ...newsOption) News {
+		content := "Created content"
+		n := db.News{
+			CategoryID:  1,
+			Title:       "Created News",
+			Content:     &content,
+			Author:      "Editor",
+			PublishedAt: db.BaseTime,
+			TagIDs:      []int{1, 2},
+			StatusID:    db.StatusPublished,
+		}
+		for _, opt := range opts {
+			opt(&n)
+		}
+		return News{News: n}
+	}
+
+	t.Run("CreatesNewsWithCategoryAndTags", func(t *testing.T) {
+		created, err := manager.CreateNews(ctx, newNews())
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.NotZero(t, created.ID, "expected generated NewsID")
+		assert.Equal(t, "Created News", created.Title)
+		assert.Equal(t, 1, created.Category.ID, "expected category to be loaded")
+		require.Len(t, created.Tags, 2, "expected tags to be attached")
+
+		got, err := manager.NewsByID(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got, "created news should be visible")
+	})
+
+	t.Run("ReturnsValidationErrorForInvalidFields", func(t *testing.T) {
+		_, err := manager.CreateNews(ctx, newNews(withTitle(""), withStatusID(db.StatusDeleted)))
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrEmptyValue, vErr.Fields[db.Columns.News.Title])
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.News.StatusID])
+	})
+
+	t.Run("ReturnsValidationErrorForDisabledCategory", func(t *testing.T) {
+		category := createTestCategory(t, tx, ctx, withCategoryStatusID(2))
+
+		_, err := manager.CreateNews(ctx, newNews(withCategoryID(category.ID)))
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.News.CategoryID])
+	})
+
+	t.Run("ReturnsValidationErrorForUnknownTags", func(t *testing.T) {
+		disabledTag := createTestTag(t, tx, ctx, withTagStatusID(2))
+
+		_, err := manager.CreateNews(ctx, newNews(func(n *db.News) { n.TagIDs = []int{1, disabledTag.ID} }))
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.News.TagIDs])
+	})
+}
+
+func TestManager_UpdateNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("UpdatesNewsAndSetsUpdatedAt", func(t *testing.T) {
+		existing := createTestNews(t, tx, ctx)
+
+		in := News{News: *existing}
+		in.Title = "Updated Title"
+		in.TagIDs = []int{3}
+
+		updated, err := manager.UpdateNews(ctx, in)
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, "Updated Title", updated.Title)
+		assert.NotNil(t, updated.UpdatedAt, "expected updatedAt to be set")
+		require.Len(t, updated.Tags, 1)
+		assert.Equal(t, 3, updated.Tags[0].ID)
+	})
+
+	t.Run("ReturnsNotFoundForUnknownNews", func(t *testing.T) {
+		in := News{News: *createTestNews(t, tx, ctx)}
+		in.ID = 99999
+
+		_, err := manager.UpdateNews(ctx, in)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("ReturnsValidationError", func(t *testing.T) {
+		in := News{News: *createTestNews(t, tx, ctx)}
+		in.Author = ""
+
+		_, err := manager.UpdateNews(ctx, in)
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrEmptyValue, vErr.Fields[db.Columns.News.Author])
+	})
+}
+
+func TestManager_PatchNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("AppliesPatchToCurrentNews", func(t *testing.T) {
+		existing := createTestNews(t, tx, ctx)
+
+		updated, err := manager.PatchNews(ctx, existing.ID, func(news *News) {
+			news.Title = "Patched Title"
+		})
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, "Patched Title", updated.Title)
+		assert.Equal(t, existing.Author, updated.Author, "expected other fields to be kept")
+		assert.Equal(t, existing.CategoryID, updated.CategoryID)
+	})
+
+	t.Run("ReturnsNotFoundForUnknownNews", func(t *testing.T) {
+		_, err := manager.PatchNews(ctx, 99999, func(*News) {})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestManager_DeleteNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("DeletesNews", func(t *testing.T) {
+		existing := createTestNews(t, tx, ctx)
+
+		err := manager.DeleteNews(ctx, existing.ID)
+		require.NoError(t, err)
+
+		got, err := manager.NewsByID(ctx, existing.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got, "deleted news should not be visible")
+
+		err = manager.DeleteNews(ctx, existing.ID)
+		assert.ErrorIs(t, err, ErrNotFound, "expected not found for already deleted news")
+	})
+
+	t.Run("ReturnsNotFoundForUnknownNews", func(t *testing.T) {
+		err := manager.DeleteNews(ctx, 99999)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestManager_SearchNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("FindsNewsByContentWord", func(t *testing.T) {
+		news, err := manager.SearchNews(ctx, "quantum", nil, nil, intPtr(1), intPtr(10))
+		require.NoError(t, err)
+		require.NotEmpty(t, news, "expected news matching query")
+		assert.Equal(t, "Quantum Computers: Future of Computing", news[0].Title, "expected best ranked news first")
+		for i := range news {
+			assertNewsBasic(t, &news[i])
+		}
+	})
+
+	t.Run("RanksTitleMatchesFirst", func(t *testing.T) {
+		contentOnly := "Some text that mentions results of the festival."
+		createTestNews(t, tx, ctx, withTitle("Weekly digest"), func(n *db.News) { n.Content = &contentOnly })
+
+		news, err := manager.SearchNews(ctx, "festival", nil, nil, intPtr(1), intPtr(10))
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(news), 2, "expected at least 2 news items")
+		assert.Equal(t, "Film Festival: Award Ceremony", news[0].Title, "expected title match ranked first")
+	})
+
+	t.Run("AppliesCategoryFilter", func(t *testing.T) {
+		news, err := manager.SearchNews(ctx, "results", nil, intPtr(2), intPtr(1), intPtr(10))
+		require.NoError(t, err)
+		require.NotEmpty(t, news, "expected news matching query")
+		for _, item := range news {
+			assert.Equal(t, 2, item.CategoryID, "expected categoryID to match")
+		}
+	})
+
+	t.Run("ExcludesInvisibleNews", func(t *testing.T) {
+		unpublished := createTestNews(t, tx, ctx, withStatusID(db.StatusDraft), withTitle("Invisible zeppelin"))
+		future := createTestNews(t, tx, ctx, withPublishedAt(time.Now().Add(24*time.Hour)), withTitle("Future zeppelin"))
+
+		news, err := manager.SearchNews(ctx, "zeppelin", nil, nil, intPtr(1), intPtr(10))
+		require.NoError(t, err)
+		for _, item := range news {
+			assert.NotEqual(t, unpublished.ID, item.ID, "news should not be returned (unpublished status)")
+			assert.NotEqual(t, future.ID, item.ID, "news should not be returned (publishedAt in future)")
+		}
+	})
+
+	t.Run("HighlightsTermsInSnippet", func(t *testing.T) {
+		content := "Astronomers observed a rare comet near Jupiter last night."
+		created := createTestNews(t, tx, ctx, withTitle("Night sky"), func(n *db.News) { n.Content = &content })
+
+		news, err := manager.SearchNews(ctx, "comet", nil, nil, intPtr(1), intPtr(10))
+		require.NoError(t, err)
+
+		var found bool
+		for _, item := range news {
+			require.NotNil(t, item.Snippet, "expected snippet for every search result")
+			if item.ID == created.ID {
+				found = true
+				assert.Contains(t, *item.Snippet, "<b>comet</b>", "expected matched term to be highlighted")
+			}
+		}
+		assert.True(t, found, "expected created news in search results")
+	})
+
+	t.Run("UsesConfiguredMarkers", func(t *testing.T) {
+		content := "A new glacier survey was published today."
+		created := createTestNews(t, tx, ctx, withTitle("Glacier report"), func(n *db.News) { n.Content = &content })
+
+		custom := NewNewsManager(tx).WithSearchConfig(SearchConfig{StartSel: "[[", StopSel: "]]"})
+		news, err := custom.SearchNews(ctx, "survey", nil, nil, intPtr(1), intPtr(10))
+		require.NoError(t, err)
+
+		var found bool
+		for _, item := range news {
+			if item.ID == created.ID {
+				found = true
+				require.NotNil(t, item.Snippet)
+				assert.Contains(t, *item.Snippet, "[[survey]]", "expected configured markers")
+			}
+		}
+		assert.True(t, found, "expected created news in search results")
+	})
+
+	t.Run("WithEmptyQueryReturnsValidationError", func(t *testing.T) {
+		_, err := manager.SearchNews(ctx, " ", nil, nil, nil, nil)
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrEmptyValue, vErr.Fields["query"])
+	})
+}
+
+func TestManager_Sitemap_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("SitemapPageCount", func(t *testing.T) {
+		count, err := manager.SitemapPageCount(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count, "expected single sitemap page for test data")
+	})
+
+	t.Run("SitemapNewsReturnsVisibleNewsWithoutContent", func(t *testing.T) {
+		unpublished := createTestNews(t, tx, ctx, withStatusID(db.StatusDraft), withTitle("Hidden sitemap news"))
+
+		total, err := manager.NewsCount(ctx, nil, nil)
+		require.NoError(t, err)
+
+		var ids []int
+		err = manager.SitemapNews(ctx, 1, func(list NewsList) error {
+			for _, n := range list {
+				assert.Nil(t, n.Content, "content should not be loaded")
+				assert.NotEmpty(t, n.Title)
+				ids = append(ids, n.ID)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, ids, total, "expected all visible news")
+		assert.NotContains(t, ids, unpublished.ID, "news should not be returned (unpublished status)")
+		assert.IsIncreasing(t, ids, "expected news sorted by newsId")
+	})
+
+	t.Run("SitemapNewsWithEmptyPage", func(t *testing.T) {
+		called := false
+		err := manager.SitemapNews(ctx, 1000, func(NewsList) error {
+			called = true
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, called, "expected no batches for empty page")
+	})
+
+	t.Run("RecentNews", func(t *testing.T) {
+		recent := createTestNews(t, tx, ctx, withPublishedAt(time.Now().Add(-time.Hour)), withTitle("Recent news"))
+
+		news, err := manager.RecentNews(ctx, time.Now().Add(-48*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, news, 1, "expected only recent news, test data is older")
+		assert.Equal(t, recent.ID, news[0].ID)
+	})
+}
+
+func TestManager_NewsByCursor_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("IteratesAllNewsInOrder", func(t *testing.T) {
+		all, err := manager.NewsByFilter(ctx, nil, nil, intPtr(1), intPtr(100))
+		require.NoError(t, err)
+
+		var (
+			ids    []int
+			cursor string
+		)
+		for {
+			news, next, err := manager.NewsByCursor(ctx, nil, nil, cursor, intPtr(3))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(news), 3)
+			for i := range news {
+				assertNewsBasic(t, &news[i])
+				ids = append(ids, news[i].ID)
+			}
+
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		expected := make([]int, len(all))
+		for i := range all {
+			expected[i] = all[i].ID
+		}
+		assert.Equal(t, expected, ids, "expected same order as page pagination")
+	})
+
+	t.Run("NextPageIsStableAfterPublishing", func(t *testing.T) {
+		first, next, err := manager.NewsByCursor(ctx, nil, nil, "", intPtr(2))
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		require.NotEmpty(t, next)
+
+		secondBefore, _, err := manager.NewsByCursor(ctx, nil, nil, next, intPtr(2))
+		require.NoError(t, err)
+
+		createTestNews(t, tx, ctx, withPublishedAt(time.Now().Add(-time.Minute)), withTitle("Just published"))
+
+		secondAfter, _, err := manager.NewsByCursor(ctx, nil, nil, next, intPtr(2))
+		require.NoError(t, err)
+		assert.Equal(t, NewsList(secondBefore).IDs(), NewsList(secondAfter).IDs(), "page should not shift")
+	})
+
+	t.Run("AppliesTagFilter", func(t *testing.T) {
+		news, _, err := manager.NewsByCursor(ctx, intPtr(1), nil, "", intPtr(10))
+		require.NoError(t, err)
+		require.NotEmpty(t, news)
+		for _, item := range news {
+			assert.Contains(t, item.TagIDs, 1, "expected tag filter")
+		}
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		_, _, err := manager.NewsByCursor(ctx, nil, nil, "not a cursor", nil)
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields["cursor"])
+	})
+
+	t.Run("CursorRoundTrip", func(t *testing.T) {
+		c := Cursor{PublishedAt: time.Date(2024, 1, 14, 12, 0, 0, 123456000, time.UTC), NewsID: 42}
+
+		parsed, err := ParseCursor(c.String())
+		require.NoError(t, err)
+		assert.True(t, c.PublishedAt.Equal(parsed.PublishedAt))
+		assert.Equal(t, c.NewsID, parsed.NewsID)
+	})
+}
+
+func TestManager_NewsPage_Integration(t *testing.T) {
+	_, ctx, manager := withTx(t)
+
+	t.Run("ReturnsItemsWithTotal", func(t *testing.T) {
+		total, err := manager.NewsCount(ctx, nil, nil)
+		require.NoError(t, err)
+
+		page, err := manager.NewsPage(ctx, nil, nil, intPtr(1), intPtr(2))
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, total, page.Total)
+		assert.Equal(t, 1, page.Page)
+		assert.Equal(t, 2, page.PageSize)
+		assert.True(t, page.HasNext, "expected next page")
+	})
+
+	t.Run("LastPageHasNoNext", func(t *testing.T) {
+		page, err := manager.NewsPage(ctx, nil, intPtr(1), intPtr(1), intPtr(100))
+		require.NoError(t, err)
+		assert.Len(t, page.Items, page.Total)
+		assert.False(t, page.HasNext)
+	})
+
+	t.Run("WithoutOuterTransaction", func(t *testing.T) {
+		page, err := testManager.NewsPage(ctx, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, defaultPageSize, page.PageSize)
+		assert.NotEmpty(t, page.Items)
+	})
+}
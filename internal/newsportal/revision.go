@@ -0,0 +1,233 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10"
+)
+
+// DiffOp is a line diff operation.
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "="
+	DiffInsert DiffOp = "+"
+	DiffDelete DiffOp = "-"
+)
+
+// revisionSort is a stable revisions order: createdAt DESC, newsRevisionId DESC.
+var revisionSort = []db.SortField{
+	db.NewSortField(db.Columns.NewsRevision.CreatedAt, true),
+	db.NewSortField(db.Columns.NewsRevision.ID, true),
+}
+
+// revisionFields are compared by NewsRevisionDiff besides content.
+var revisionFields = []string{
+	db.Columns.NewsRevision.CategoryID,
+	db.Columns.NewsRevision.Title,
+	db.Columns.NewsRevision.TagIDs,
+}
+
+// NewsRevision is a snapshot of news title, content, category and tags.
+type NewsRevision struct {
+	db.NewsRevision
+}
+
+// DiffLine is a line of content diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// NewsRevisionDiff is a difference between two revisions of news.
+type NewsRevisionDiff struct {
+	From NewsRevision
+	To   NewsRevision
+	// Fields contains changed title, categoryId and tagIds keyed by db column name.
+	Fields map[string]AuditChange
+	// Content is a line diff of content.
+	Content []DiffLine
+}
+
+// NewsRevisions returns revisions of news, the newest first. Returns ErrNotFound for unknown or deleted news.
+func (u *Manager) NewsRevisions(ctx context.Context, newsID int, page, pageSize *int) ([]NewsRevision, error) {
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	if news, err := u.editRepo.NewsByID(ctx, newsID); err != nil {
+		return nil, fmt.Errorf("db get news by id: %w", err)
+	} else if news == nil {
+		return nil, ErrNotFound
+	}
+
+	list, err := u.editRepo.NewsRevisionsByFilters(ctx, &db.NewsRevisionSearch{NewsID: &newsID}, db.NewPager(p, ps), db.WithSort(revisionSort...))
+	if err != nil {
+		return nil, fmt.Errorf("db get news revisions: %w", err)
+	}
+
+	return Map(list, func(r db.NewsRevision) NewsRevision { return NewsRevision{NewsRevision: r} }), nil
+}
+
+// NewsRevision returns revision of news. Returns ErrNotFound for unknown revision or revision of other news.
+func (u *Manager) NewsRevision(ctx context.Context, newsID, revisionID int) (*NewsRevision, error) {
+	revision, err := u.editRepo.OneNewsRevision(ctx, &db.NewsRevisionSearch{ID: &revisionID, NewsID: &newsID})
+	if err != nil {
+		return nil, fmt.Errorf("db get news revision: %w", err)
+	} else if revision == nil {
+		return nil, ErrNotFound
+	}
+
+	return &NewsRevision{NewsRevision: *revision}, nil
+}
+
+// NewsRevisionDiff returns changed fields and line diff of content between two revisions of news.
+func (u *Manager) NewsRevisionDiff(ctx context.Context, newsID, fromRevisionID, toRevisionID int) (*NewsRevisionDiff, error) {
+	from, err := u.NewsRevision(ctx, newsID, fromRevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := u.NewsRevision(ctx, newsID, toRevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := auditDiff(from.NewsRevision, to.NewsRevision)
+	fields := make(map[string]AuditChange, len(revisionFields))
+	for _, f := range revisionFields {
+		if change, ok := changes[f].(AuditChange); ok {
+			fields[f] = change
+		}
+	}
+
+	return &NewsRevisionDiff{
+		From:    *from,
+		To:      *to,
+		Fields:  fields,
+		Content: diffLines(stringValue(from.Content), stringValue(to.Content)),
+	}, nil
+}
+
+// RestoreNewsRevision updates news with title, content, category and tags of revision, it adds a new revision.
+// Returns ErrNotFound for unknown or deleted news and unknown revision.
+func (u *Manager) RestoreNewsRevision(ctx context.Context, newsID, revisionID int) (*News, error) {
+	var restored *News
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		revision, err := m.NewsRevision(ctx, newsID, revisionID)
+		if err != nil {
+			return err
+		}
+
+		news, err := m.NewsForEdit(ctx, newsID)
+		if err != nil {
+			return err
+		}
+
+		in := News{News: news.News}
+		in.News.Category = nil
+		in.CategoryID = revision.CategoryID
+		in.Title = revision.Title
+		in.Content = revision.Content
+		in.TagIDs = revision.TagIDs
+
+		restored, err = m.UpdateNews(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateNews()
+
+	return restored, nil
+}
+
+// addNewsRevision adds revision of saved news. It must be called with manager bound to the change transaction.
+func (u *Manager) addNewsRevision(ctx context.Context, news db.News) error {
+	revision := &db.NewsRevision{
+		NewsID:     news.ID,
+		CategoryID: news.CategoryID,
+		Title:      news.Title,
+		Content:    news.Content,
+		TagIDs:     news.TagIDs,
+		Actor:      actorFromContext(ctx),
+		CreatedAt:  time.Now(),
+	}
+
+	if revision.TagIDs == nil {
+		revision.TagIDs = []int{}
+	}
+
+	if _, err := u.editRepo.AddNewsRevision(ctx, revision); err != nil {
+		return fmt.Errorf("db add news revision: %w", err)
+	}
+
+	return nil
+}
+
+// diffLines returns line diff of texts by the longest common subsequence of lines.
+func diffLines(from, to string) []DiffLine {
+	a, b := splitLines(from), splitLines(to)
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	r := make([]DiffLine, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			r = append(r, DiffLine{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			r = append(r, DiffLine{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			r = append(r, DiffLine{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		r = append(r, DiffLine{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		r = append(r, DiffLine{Op: DiffInsert, Text: b[j]})
+	}
+
+	return r
+}
+
+// splitLines returns lines of text without line breaks, empty text has no lines.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), "\n")
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
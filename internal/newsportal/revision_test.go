@@ -0,0 +1,135 @@
+package newsportal
+
+import (
+	"testing"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     []DiffLine
+	}{
+		{name: "Empty"},
+		{
+			name: "Insert",
+			to:   "a\nb",
+			want: []DiffLine{{DiffInsert, "a"}, {DiffInsert, "b"}},
+		},
+		{
+			name: "Delete",
+			from: "a\nb\n",
+			want: []DiffLine{{DiffDelete, "a"}, {DiffDelete, "b"}},
+		},
+		{
+			name: "Change",
+			from: "a\nb\nc",
+			to:   "a\nB\nc\nd",
+			want: []DiffLine{{DiffEqual, "a"}, {DiffDelete, "b"}, {DiffInsert, "B"}, {DiffEqual, "c"}, {DiffInsert, "d"}},
+		},
+		{
+			name: "CRLF",
+			from: "a\r\nb",
+			to:   "a\nb",
+			want: []DiffLine{{DiffEqual, "a"}, {DiffEqual, "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffLines(tt.from, tt.to)
+			if len(tt.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestManager_NewsRevisions_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+	ctx = WithPrincipal(ctx, &Principal{Subject: "editor@example.com", Role: RoleEditor})
+
+	content := "first line\nsecond line"
+	created, err := manager.CreateNews(ctx, News{News: db.News{
+		CategoryID:  1,
+		Title:       "Revised News",
+		Content:     &content,
+		Author:      "Editor",
+		PublishedAt: db.BaseTime,
+		TagIDs:      []int{1},
+	}})
+	require.NoError(t, err)
+
+	updatedContent := "first line\nchanged line"
+	in := News{News: created.News}
+	in.Title = "Revised News Updated"
+	in.Content = &updatedContent
+	in.TagIDs = []int{1, 2}
+	_, err = manager.UpdateNews(ctx, in)
+	require.NoError(t, err)
+
+	revisions, err := manager.NewsRevisions(ctx, created.ID, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	last, first := revisions[0], revisions[1]
+
+	t.Run("Snapshots", func(t *testing.T) {
+		assert.Equal(t, "Revised News", first.Title)
+		assert.Equal(t, content, *first.Content)
+		assert.Equal(t, []int{1}, first.TagIDs)
+		assert.Equal(t, "Revised News Updated", last.Title)
+		assert.Equal(t, "editor@example.com", last.Actor)
+	})
+
+	t.Run("Revision", func(t *testing.T) {
+		got, err := manager.NewsRevision(ctx, created.ID, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.Title, got.Title)
+
+		_, err = manager.NewsRevision(ctx, created.ID+1, first.ID)
+		assert.ErrorIs(t, err, ErrNotFound, "expected not found for revision of other news")
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		diff, err := manager.NewsRevisionDiff(ctx, created.ID, first.ID, last.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]AuditChange{
+			db.Columns.NewsRevision.Title:  {Old: "Revised News", New: "Revised News Updated"},
+			db.Columns.NewsRevision.TagIDs: {Old: []int{1}, New: []int{1, 2}},
+		}, diff.Fields)
+		assert.Equal(t, []DiffLine{
+			{DiffEqual, "first line"},
+			{DiffDelete, "second line"},
+			{DiffInsert, "changed line"},
+		}, diff.Content)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		restored, err := manager.RestoreNewsRevision(ctx, created.ID, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Revised News", restored.Title)
+		assert.Equal(t, content, *restored.Content)
+		assert.Len(t, restored.Tags, 1)
+
+		revisions, err := manager.NewsRevisions(ctx, created.ID, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3, "expected restore to add a revision")
+		assert.Equal(t, "Revised News", revisions[0].Title)
+
+		_, err = manager.RestoreNewsRevision(ctx, created.ID, 99999)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("UnknownNews", func(t *testing.T) {
+		deleted := createTestNews(t, tx, ctx, withStatusID(db.StatusDeleted))
+
+		_, err := manager.NewsRevisions(ctx, deleted.ID, nil, nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,96 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+)
+
+const (
+	// SitemapPageSize is a newsId range of one sitemap page. Protocol limit is 50000 URLs per sitemap.
+	SitemapPageSize = 10000
+	// sitemapBatchSize is a number of news loaded per query while streaming sitemap page.
+	sitemapBatchSize = 1000
+	// RecentNewsMaxSize is a Google News sitemap limit.
+	RecentNewsMaxSize = 1000
+)
+
+// sitemapColumns are news columns required for sitemaps, without content.
+var sitemapColumns = []string{
+	db.Columns.News.ID,
+	db.Columns.News.Title,
+	db.Columns.News.PublishedAt,
+	db.Columns.News.UpdatedAt,
+	db.Columns.News.Category,
+}
+
+// SitemapPageCount returns number of sitemap pages. Pages are newsId ranges of SitemapPageSize,
+// so count is based on the latest visible newsId.
+func (u *Manager) SitemapPageCount(ctx context.Context) (int, error) {
+	last, err := u.repo.NewsByFilters(ctx, publishedNewsSearch(nil, nil), db.PagerOne,
+		db.WithColumns(db.Columns.News.ID, db.Columns.News.Category),
+		db.WithSort(db.NewSortField(db.Columns.News.ID, true)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("db get last news: %w", err)
+	} else if len(last) == 0 {
+		return 0, nil
+	}
+
+	return (last[0].ID + SitemapPageSize - 1) / SitemapPageSize, nil
+}
+
+// SitemapNews calls fn with batches of visible news from sitemap page, sorted by newsId.
+// News are loaded with keyset pagination by newsId and contain only id, title, publishedAt and updatedAt.
+func (u *Manager) SitemapNews(ctx context.Context, page int, fn func(NewsList) error) error {
+	if page < 1 {
+		return fmt.Errorf("invalid sitemap page: %d", page)
+	}
+
+	lastID := (page - 1) * SitemapPageSize
+	maxID := page * SitemapPageSize
+
+	for {
+		search := publishedNewsSearch(nil, nil)
+		search.IDGT = &lastID
+		search.IDLE = &maxID
+
+		list, err := u.repo.NewsByFilters(ctx, search, db.NewPager(1, sitemapBatchSize),
+			db.WithColumns(sitemapColumns...),
+			db.WithSort(db.NewSortField(db.Columns.News.ID, false)),
+		)
+		if err != nil {
+			return fmt.Errorf("db get sitemap news: %w", err)
+		} else if len(list) == 0 {
+			return nil
+		}
+
+		if err := fn(NewNewsList(list)); err != nil {
+			return err
+		}
+
+		if len(list) < sitemapBatchSize {
+			return nil
+		}
+		lastID = list[len(list)-1].ID
+	}
+}
+
+// RecentNews returns up to RecentNewsMaxSize visible news published since, sorted by publishedAt DESC.
+// News contain only id, title, publishedAt and updatedAt.
+func (u *Manager) RecentNews(ctx context.Context, since time.Time) ([]News, error) {
+	search := publishedNewsSearch(nil, nil)
+	search.PublishedAtGE = &since
+
+	list, err := u.repo.NewsByFilters(ctx, search, db.NewPager(1, RecentNewsMaxSize),
+		db.WithColumns(sitemapColumns...),
+		db.WithSort(db.NewSortField(db.Columns.News.PublishedAt, true)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("db get recent news: %w", err)
+	}
+
+	return NewNewsList(list), nil
+}
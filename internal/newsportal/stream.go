@@ -0,0 +1,266 @@
+package newsportal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10"
+)
+
+const (
+	// NewsChannel is a Postgres notification channel with IDs of published and updated news.
+	NewsChannel = "news_events"
+
+	// streamBufferSize is a number of news buffered for subscriber, slow subscribers are closed on overflow.
+	streamBufferSize = 16
+)
+
+// StreamConfig configures stream of published news.
+type StreamConfig struct {
+	// Enabled turns on stream.
+	Enabled bool
+	// Heartbeat is a period of keep-alive messages, default is 30 seconds.
+	Heartbeat time.Duration
+}
+
+// streamNotification is a payload of NewsChannel notification.
+type streamNotification struct {
+	Type   EventType `json:"type"`
+	NewsID int       `json:"newsId"`
+}
+
+// notifyNewsEvent sends published or updated news ID to NewsChannel, notification is delivered after transaction commit.
+func (u *Manager) notifyNewsEvent(ctx context.Context, from State, news db.News) error {
+	eventType, ok := newsEventType(from, State(news.StatusID))
+	if !ok || eventType == EventNewsDeleted {
+		return nil
+	}
+
+	payload, err := json.Marshal(streamNotification{Type: eventType, NewsID: news.ID})
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.dbc.ExecContext(ctx, "SELECT pg_notify(?, ?)", NewsChannel, string(payload)); err != nil {
+		return fmt.Errorf("db notify news event: %w", err)
+	}
+
+	return nil
+}
+
+// NewsAfter retrieves news with optional filtering by tagID and categoryID published after cursor position,
+// sorted by publishedAt ASC, newsId ASC. Returns NewsSummary (without content).
+func (u *Manager) NewsAfter(ctx context.Context, tagID, categoryID *int, after Cursor, limit int) ([]News, error) {
+	dbNews, err := u.repo.NewsByFilters(ctx, publishedNewsSearch(tagID, categoryID), db.NewPager(1, limit),
+		db.WithRelations(db.Columns.News.Category),
+		db.WithSort(db.NewSortField(db.Columns.News.PublishedAt, false), db.NewSortField(db.Columns.News.ID, false)),
+		db.WithNewsSeekAfter(after.PublishedAt, after.NewsID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("db get news after cursor: %w", err)
+	}
+
+	newsList := NewNewsList(dbNews)
+
+	err = u.fillTags(ctx, newsList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach tags to news: %w", err)
+	}
+
+	return newsList, nil
+}
+
+// Subscription receives events of news matching filter from NewsStream.
+type Subscription struct {
+	filter     NewsFilter
+	eventTypes []EventType
+	events     chan Event
+	once       sync.Once
+}
+
+// Events returns channel of news events. It is closed when subscription is removed,
+// stream is stopped or subscriber is too slow.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// matches reports whether event matches subscription event types and filter.
+func (s *Subscription) matches(e Event) bool {
+	if len(s.eventTypes) > 0 && !slices.Contains(s.eventTypes, e.Type) {
+		return false
+	}
+
+	if s.filter.CategoryID != nil && e.News.CategoryID != *s.filter.CategoryID {
+		return false
+	}
+
+	return s.filter.TagID == nil || slices.Contains(e.News.TagIDs, *s.filter.TagID)
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() { close(s.events) })
+}
+
+// NewsStream delivers events of published and updated news to subscribers. News IDs are received from NewsChannel,
+// so subscribers of all app instances get news changed by any instance.
+type NewsStream struct {
+	manager *Manager
+	pgdb    *pg.DB
+	logger  *slog.Logger
+	config  StreamConfig
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewNewsStream(manager *Manager, pgdb *pg.DB, logger *slog.Logger, cfg StreamConfig) *NewsStream {
+	if cfg.Heartbeat <= 0 {
+		cfg.Heartbeat = 30 * time.Second
+	}
+
+	return &NewsStream{
+		manager: manager,
+		pgdb:    pgdb,
+		logger:  logger,
+		config:  cfg,
+		subs:    map[*Subscription]struct{}{},
+	}
+}
+
+// Config returns stream config with defaults.
+func (s *NewsStream) Config() StreamConfig {
+	return s.config
+}
+
+// Subscribe adds subscription to events of news matching filter. Without eventTypes all events are received.
+// Subscription of stopped stream is closed.
+func (s *NewsStream) Subscribe(filter NewsFilter, eventTypes ...EventType) *Subscription {
+	sub := &Subscription{filter: filter, eventTypes: eventTypes, events: make(chan Event, streamBufferSize)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		sub.close()
+	} else {
+		s.subs[sub] = struct{}{}
+	}
+
+	return sub
+}
+
+// Unsubscribe removes and closes subscription.
+func (s *NewsStream) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subs, sub)
+	sub.close()
+}
+
+// Start listens NewsChannel in background until Stop is called or ctx is done.
+func (s *NewsStream) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	ln := s.pgdb.Listen(ctx, NewsChannel)
+	go s.run(ctx, ln)
+	s.logger.Info("news stream started", "channel", NewsChannel)
+}
+
+// Stop stops listening and closes all subscriptions, then waits for listener to finish until ctx is done.
+func (s *NewsStream) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	select {
+	case <-s.done:
+		s.logger.Info("news stream stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *NewsStream) run(ctx context.Context, ln *pg.Listener) {
+	defer close(s.done)
+	defer s.closeAll()
+	defer ln.Close()
+
+	ch := ln.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			var sn streamNotification
+			if err := json.Unmarshal([]byte(n.Payload), &sn); err != nil {
+				s.logger.Error("invalid news stream notification", "payload", n.Payload)
+				continue
+			}
+
+			s.publish(ctx, sn.Type, sn.NewsID)
+		}
+	}
+}
+
+// publish sends news event to matching subscribers. News changed by other instances are not
+// in local cache, so news caches are dropped and news is loaded from db.
+func (s *NewsStream) publish(ctx context.Context, eventType EventType, newsID int) {
+	s.manager.invalidateNews()
+
+	news, err := s.manager.newsByID(ctx, newsID)
+	if err != nil {
+		s.logger.Error("failed to load news of stream event", "newsId", newsID, "error", err)
+		return
+	} else if news == nil {
+		return
+	}
+
+	event := Event{Type: eventType, News: *news, CreatedAt: time.Now()}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		if !sub.matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			// slow subscriber resumes from the last received news after reconnect
+			delete(s.subs, sub)
+			sub.close()
+		}
+	}
+}
+
+// closeAll closes all subscriptions, new subscriptions are closed immediately.
+func (s *NewsStream) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		sub.close()
+	}
+	s.subs = map[*Subscription]struct{}{}
+	s.closed = true
+}
@@ -0,0 +1,91 @@
+package newsportal
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscription_matches(t *testing.T) {
+	categoryID, tagID, otherID := 1, 2, 3
+	event := Event{Type: EventNewsPublished, News: News{News: db.News{ID: 1, CategoryID: categoryID, TagIDs: []int{tagID}}}}
+
+	tests := []struct {
+		name       string
+		filter     NewsFilter
+		eventTypes []EventType
+		want       bool
+	}{
+		{name: "Empty", want: true},
+		{name: "Category", filter: NewsFilter{CategoryID: &categoryID}, want: true},
+		{name: "OtherCategory", filter: NewsFilter{CategoryID: &otherID}},
+		{name: "Tag", filter: NewsFilter{TagID: &tagID}, want: true},
+		{name: "OtherTag", filter: NewsFilter{TagID: &otherID}},
+		{name: "CategoryAndTag", filter: NewsFilter{CategoryID: &categoryID, TagID: &tagID}, want: true},
+		{name: "CategoryAndOtherTag", filter: NewsFilter{CategoryID: &categoryID, TagID: &otherID}},
+		{name: "EventType", eventTypes: []EventType{EventNewsUpdated, EventNewsPublished}, want: true},
+		{name: "OtherEventType", eventTypes: []EventType{EventNewsUpdated}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &Subscription{filter: tt.filter, eventTypes: tt.eventTypes}
+			assert.Equal(t, tt.want, sub.matches(event))
+		})
+	}
+}
+
+func TestNewsStream_Subscribe(t *testing.T) {
+	s := NewNewsStream(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), StreamConfig{})
+	assert.Equal(t, 30*time.Second, s.Config().Heartbeat)
+
+	sub := s.Subscribe(NewsFilter{})
+	other := s.Subscribe(NewsFilter{})
+	assert.Len(t, s.subs, 2)
+
+	s.Unsubscribe(sub)
+	_, ok := <-sub.Events()
+	assert.False(t, ok, "expected unsubscribed channel to be closed")
+	assert.Len(t, s.subs, 1)
+
+	s.closeAll()
+	_, ok = <-other.Events()
+	assert.False(t, ok, "expected channel to be closed with stream")
+
+	_, ok = <-s.Subscribe(NewsFilter{}).Events()
+	assert.False(t, ok, "expected subscription of closed stream to be closed")
+	assert.Empty(t, s.subs)
+}
+
+func TestManager_NewsAfter_Integration(t *testing.T) {
+	_, ctx, manager := withTx(t)
+
+	all, err := manager.NewsAfter(ctx, nil, nil, Cursor{}, 100)
+	require.NoError(t, err)
+	require.Greater(t, len(all), 1, "expected test data")
+
+	for i := 1; i < len(all); i++ {
+		assert.False(t, all[i].PublishedAt.Before(all[i-1].PublishedAt), "expected ascending order by publishedAt")
+	}
+
+	got, err := manager.NewsAfter(ctx, nil, nil, NewCursor(all[0]), 100)
+	require.NoError(t, err)
+	assert.Equal(t, Map(all[1:], func(n News) int { return n.ID }), Map(got, func(n News) int { return n.ID }))
+
+	got, err = manager.NewsAfter(ctx, nil, nil, NewCursor(all[len(all)-1]), 100)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	categoryID := all[0].CategoryID
+	got, err = manager.NewsAfter(ctx, nil, &categoryID, Cursor{}, 100)
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+	for _, n := range got {
+		assert.Equal(t, categoryID, n.CategoryID)
+	}
+}
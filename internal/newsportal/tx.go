@@ -0,0 +1,50 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-pg/pg/v10"
+)
+
+// isolationRepeatableRead is a transaction isolation level with one snapshot for all queries.
+const isolationRepeatableRead = "REPEATABLE READ"
+
+// txRunner runs function in transaction, it is implemented by *pg.DB and db.DB.
+type txRunner interface {
+	RunInTransaction(ctx context.Context, fn func(*pg.Tx) error) error
+}
+
+// inTransaction calls fn with manager copy bound to transaction. New transaction is started
+// with isolation level if it is not empty. If manager is already created over *pg.Tx, fn is called within it.
+// Otherwise fn is called without transaction.
+func (u *Manager) inTransaction(ctx context.Context, isolation string, fn func(m *Manager, tx *pg.Tx) error) error {
+	switch dbc := u.dbc.(type) {
+	case *pg.Tx:
+		return fn(u.withTx(dbc), dbc)
+	case txRunner:
+		return dbc.RunInTransaction(ctx, func(tx *pg.Tx) error {
+			if isolation != "" {
+				if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL ?", pg.SafeQuery(isolation)); err != nil {
+					return fmt.Errorf("set transaction isolation level: %w", err)
+				}
+			}
+
+			return fn(u.withTx(tx), tx)
+		})
+	}
+
+	return fn(u, nil)
+}
+
+// withTx returns manager copy with repositories bound to transaction.
+func (u *Manager) withTx(tx *pg.Tx) *Manager {
+	m := *u
+	m.dbc = tx
+	m.inTx = true
+	m.repo = u.repo.WithTransaction(tx)
+	m.editRepo = u.editRepo.WithTransaction(tx)
+	m.webhookRepo = u.webhookRepo.WithTransaction(tx)
+
+	return &m
+}
@@ -0,0 +1,517 @@
+package newsportal
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// Webhook delivery states. Pending deliveries are sent until delivered or dead after the last failed attempt.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+const (
+	// HeaderWebhookEvent contains event type of webhook request.
+	HeaderWebhookEvent = "X-Webhook-Event"
+	// HeaderWebhookDelivery contains delivery ID, it is the same for all attempts of a delivery.
+	HeaderWebhookDelivery = "X-Webhook-Delivery"
+	// HeaderWebhookSignature contains "sha256=" and hex HMAC-SHA256 of request body with webhook secret.
+	HeaderWebhookSignature = "X-Webhook-Signature"
+
+	// webhookSecretPrefix marks generated webhook secrets.
+	webhookSecretPrefix = "whsec_"
+	// maxWebhookError is a max length of response error saved in delivery.
+	maxWebhookError = 1024
+)
+
+// errPrivateAddress is returned for webhook addresses in private networks.
+var errPrivateAddress = errors.New("webhook address is not public")
+
+// WebhookConfig configures webhook deliveries.
+type WebhookConfig struct {
+	// Enabled turns on delivery worker and webhook RPC methods.
+	Enabled bool
+	// Interval is a period of sending pending deliveries, default is 10 seconds.
+	Interval time.Duration
+	// BatchSize is a max number of deliveries sent per run, default is 100.
+	BatchSize int
+	// Timeout is a timeout of webhook request, default is 10 seconds.
+	Timeout time.Duration
+	// MaxAttempts is a number of attempts before delivery becomes dead, default is 10.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff limit exponential delay between attempts, defaults are 30 seconds and 1 hour.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// AllowPrivateNetworks allows webhooks to loopback, private and link-local addresses, e.g. for local development.
+	AllowPrivateNetworks bool
+}
+
+// withDefaults returns config with defaults instead of empty values.
+func (c WebhookConfig) withDefaults() WebhookConfig {
+	if c.Interval <= 0 {
+		c.Interval = 10 * time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 10
+	}
+	if c.MinBackoff <= 0 {
+		c.MinBackoff = 30 * time.Second
+	}
+	if c.MaxBackoff < c.MinBackoff {
+		c.MaxBackoff = max(time.Hour, c.MinBackoff)
+	}
+
+	return c
+}
+
+// Webhook is a webhook subscription.
+type Webhook struct {
+	db.Webhook
+}
+
+// WebhookDelivery is a webhook event delivery.
+type WebhookDelivery struct {
+	db.WebhookDelivery
+}
+
+// WebhookPayload is a JSON body of webhook request.
+type WebhookPayload struct {
+	DeliveryID int            `json:"deliveryId"`
+	Event      EventType      `json:"event"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	News       map[string]any `json:"news"`
+}
+
+type WebhookManager struct {
+	repo   db.WebhookRepo
+	config WebhookConfig
+	client *http.Client
+}
+
+func NewWebhookManager(dbc orm.DB, cfg WebhookConfig) *WebhookManager {
+	cfg = cfg.withDefaults()
+
+	return &WebhookManager{
+		repo:   db.NewWebhookRepo(dbc),
+		config: cfg,
+		client: newWebhookClient(cfg),
+	}
+}
+
+// newWebhookClient returns client that doesn't follow redirects and, unless private networks are allowed,
+// refuses connections to not public addresses after DNS resolution, so webhooks can't reach internal services.
+func newWebhookClient(cfg WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(ap.Addr()) {
+				return fmt.Errorf("dial %s: %w", address, errPrivateAddress)
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// isPublicAddr reports whether address is not loopback, private, link-local, unspecified or multicast.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
+
+// checkWebhookURL checks that webhook URL is http or https and, unless private networks are allowed,
+// its host resolves to public addresses only.
+func (w *WebhookManager) checkWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.New("webhook url must be absolute http or https url")
+	} else if w.config.AllowPrivateNetworks {
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("resolve webhook host: %w", err)
+	}
+	for _, addr := range addrs {
+		if !isPublicAddr(addr) {
+			return fmt.Errorf("%s: %w", addr, errPrivateAddress)
+		}
+	}
+
+	return nil
+}
+
+// Config returns webhook config with defaults.
+func (w *WebhookManager) Config() WebhookConfig {
+	return w.config
+}
+
+// Webhooks returns not deleted webhooks, the newest first.
+func (w *WebhookManager) Webhooks(ctx context.Context) ([]Webhook, error) {
+	list, err := w.repo.WebhooksByFilters(ctx, nil, db.PagerNoLimit, w.repo.DefaultWebhookSort())
+	if err != nil {
+		return nil, fmt.Errorf("db get webhooks: %w", err)
+	}
+
+	return Map(list, func(wh db.Webhook) Webhook { return Webhook{Webhook: wh} }), nil
+}
+
+// CreateWebhook adds enabled webhook subscribed to event types. Secret is generated if empty.
+// URL host must resolve to public addresses unless private networks are allowed.
+func (w *WebhookManager) CreateWebhook(ctx context.Context, rawURL string, eventTypes []EventType, secret string) (*Webhook, error) {
+	errs := map[string]string{}
+	if err := w.checkWebhookURL(ctx, rawURL); err != nil {
+		errs[db.Columns.Webhook.URL] = db.ErrWrongValue
+	}
+
+	if len(eventTypes) == 0 {
+		errs[db.Columns.Webhook.EventTypes] = db.ErrEmptyValue
+	}
+	for _, et := range eventTypes {
+		if !et.Valid() {
+			errs[db.Columns.Webhook.EventTypes] = db.ErrWrongValue
+		}
+	}
+
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, fmt.Errorf("generate webhook secret: %w", err)
+		}
+	}
+
+	webhook := db.Webhook{
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: Map(eventTypes, func(et EventType) string { return string(et) }),
+		CreatedAt:  time.Now(),
+		StatusID:   db.StatusEnabled,
+	}
+	if fields, ok := webhook.Validate(); !ok {
+		for field, reason := range fields {
+			errs[field] = reason
+		}
+	}
+	if len(errs) > 0 {
+		return nil, ValidationError{Fields: errs}
+	}
+
+	if _, err := w.repo.AddWebhook(ctx, &webhook); err != nil {
+		return nil, fmt.Errorf("db add webhook: %w", err)
+	}
+
+	return &Webhook{Webhook: webhook}, nil
+}
+
+// DisableWebhook disables webhook, its pending deliveries become dead on the next attempt.
+// Returns ErrNotFound for unknown or deleted webhook.
+func (w *WebhookManager) DisableWebhook(ctx context.Context, id int) error {
+	existing, err := w.repo.WebhookByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("db get webhook by id: %w", err)
+	} else if existing == nil {
+		return ErrNotFound
+	}
+
+	existing.StatusID = db.StatusDisabled
+	if _, err := w.repo.UpdateWebhook(ctx, existing, db.WithColumns(db.Columns.Webhook.StatusID)); err != nil {
+		return fmt.Errorf("db update webhook: %w", err)
+	}
+
+	return nil
+}
+
+// WebhookDeliveries returns deliveries of webhook with optional state, the newest first.
+// Returns ErrNotFound for unknown or deleted webhook.
+func (w *WebhookManager) WebhookDeliveries(ctx context.Context, webhookID int, state *string, page, pageSize *int) ([]WebhookDelivery, error) {
+	p, ps, err := validatePagination(page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	if err := w.checkWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	search := &db.WebhookDeliverySearch{WebhookID: &webhookID, State: state}
+	list, err := w.repo.WebhookDeliveriesByFilters(ctx, search, db.NewPager(p, ps),
+		db.WithSort(db.NewSortField(db.Columns.WebhookDelivery.CreatedAt, true), db.NewSortField(db.Columns.WebhookDelivery.ID, true)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("db get webhook deliveries: %w", err)
+	}
+
+	return Map(list, func(d db.WebhookDelivery) WebhookDelivery { return WebhookDelivery{WebhookDelivery: d} }), nil
+}
+
+// RedeliverWebhook resets attempts of dead deliveries of webhook, or of one delivery in any state,
+// and queues them for sending. Returns number of queued deliveries and ErrNotFound for unknown webhook or delivery.
+func (w *WebhookManager) RedeliverWebhook(ctx context.Context, webhookID int, deliveryID *int) (int, error) {
+	if err := w.checkWebhook(ctx, webhookID); err != nil {
+		return 0, err
+	}
+
+	search := &db.WebhookDeliverySearch{WebhookID: &webhookID, ID: deliveryID}
+	if deliveryID == nil {
+		dead := DeliveryDead
+		search.State = &dead
+	}
+
+	list, err := w.repo.WebhookDeliveriesByFilters(ctx, search, db.PagerNoLimit)
+	if err != nil {
+		return 0, fmt.Errorf("db get webhook deliveries: %w", err)
+	} else if deliveryID != nil && len(list) == 0 {
+		return 0, ErrNotFound
+	}
+
+	for _, d := range list {
+		d.State, d.Attempts, d.NextAttemptAt, d.DeliveredAt = DeliveryPending, 0, time.Now(), nil
+		_, err := w.repo.UpdateWebhookDelivery(ctx, &d, db.WithColumns(
+			db.Columns.WebhookDelivery.State, db.Columns.WebhookDelivery.Attempts,
+			db.Columns.WebhookDelivery.NextAttemptAt, db.Columns.WebhookDelivery.DeliveredAt,
+		))
+		if err != nil {
+			return 0, fmt.Errorf("db update webhook delivery: %w", err)
+		}
+	}
+
+	return len(list), nil
+}
+
+// checkWebhook returns ErrNotFound for unknown or deleted webhook.
+func (w *WebhookManager) checkWebhook(ctx context.Context, webhookID int) error {
+	webhook, err := w.repo.WebhookByID(ctx, webhookID)
+	if err != nil {
+		return fmt.Errorf("db get webhook by id: %w", err)
+	} else if webhook == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// DeliverWebhooks sends pending deliveries with next attempt time in the past, the oldest first.
+// Failed deliveries are retried with exponential backoff and become dead after MaxAttempts.
+// Returns number of sent deliveries.
+func (w *WebhookManager) DeliverWebhooks(ctx context.Context) (int, error) {
+	now := time.Now()
+	state := DeliveryPending
+	search := &db.WebhookDeliverySearch{State: &state, NextAttemptAtLE: &now}
+
+	list, err := w.repo.WebhookDeliveriesByFilters(ctx, search, db.NewPager(1, w.config.BatchSize), w.repo.FullWebhookDelivery(),
+		db.WithSort(db.NewSortField(db.Columns.WebhookDelivery.NextAttemptAt, false), db.NewSortField(db.Columns.WebhookDelivery.ID, false)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("db get pending webhook deliveries: %w", err)
+	}
+
+	var sent int
+	for _, d := range list {
+		if ctx.Err() != nil {
+			break
+		}
+
+		err := w.deliver(ctx, d)
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := w.saveAttempt(ctx, d, err); err != nil {
+			return sent, err
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+// deliver sends delivery to enabled webhook.
+func (w *WebhookManager) deliver(ctx context.Context, d db.WebhookDelivery) error {
+	if d.Webhook == nil || d.Webhook.StatusID != db.StatusEnabled {
+		return errors.New("webhook is disabled")
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		DeliveryID: d.ID,
+		Event:      EventType(d.EventType),
+		CreatedAt:  d.CreatedAt,
+		News:       d.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookEvent, d.EventType)
+	req.Header.Set(HeaderWebhookDelivery, fmt.Sprint(d.ID))
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(d.Webhook.Secret, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookError))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, b)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+// saveAttempt saves result of delivery attempt: delivered, pending with next attempt time or dead.
+func (w *WebhookManager) saveAttempt(ctx context.Context, d db.WebhookDelivery, sendErr error) error {
+	now := time.Now()
+	d.Attempts++
+	d.LastError = nil
+
+	switch {
+	case sendErr == nil:
+		d.State, d.DeliveredAt = DeliveryDelivered, &now
+	case d.Attempts >= w.config.MaxAttempts || d.Webhook == nil || d.Webhook.StatusID != db.StatusEnabled:
+		d.State = DeliveryDead
+	default:
+		d.NextAttemptAt = now.Add(w.backoff(d.Attempts))
+	}
+
+	if sendErr != nil {
+		msg := sendErr.Error()
+		d.LastError = &msg
+	}
+
+	_, err := w.repo.UpdateWebhookDelivery(ctx, &d, db.WithColumns(
+		db.Columns.WebhookDelivery.State, db.Columns.WebhookDelivery.Attempts, db.Columns.WebhookDelivery.NextAttemptAt,
+		db.Columns.WebhookDelivery.LastError, db.Columns.WebhookDelivery.DeliveredAt,
+	))
+	if err != nil {
+		return fmt.Errorf("db update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// backoff returns delay before the next attempt: MinBackoff doubled after every failed attempt, up to MaxBackoff.
+func (w *WebhookManager) backoff(attempts int) time.Duration {
+	d := w.config.MinBackoff
+	for i := 1; i < attempts && d < w.config.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, w.config.MaxBackoff)
+}
+
+// SignWebhook returns signature of webhook request body: "sha256=" and hex HMAC-SHA256 of body with secret.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookSecret returns random webhook secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// enqueueWebhooks adds deliveries of news event to enabled webhooks subscribed to it,
+// deliveries are saved in transaction of news change.
+func (u *Manager) enqueueWebhooks(ctx context.Context, from State, news db.News) error {
+	eventType, ok := newsEventType(from, State(news.StatusID))
+	if !ok {
+		return nil
+	}
+
+	et := string(eventType)
+	webhooks, err := u.webhookRepo.WebhooksByFilters(ctx, &db.WebhookSearch{EventType: &et}, db.PagerNoLimit)
+	if err != nil {
+		return fmt.Errorf("db get webhooks: %w", err)
+	}
+
+	now := time.Now()
+	for _, wh := range webhooks {
+		delivery := db.WebhookDelivery{
+			WebhookID:     wh.ID,
+			EventType:     et,
+			Payload:       webhookNews(news),
+			State:         DeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+
+		if _, err := u.webhookRepo.AddWebhookDelivery(ctx, &delivery); err != nil {
+			return fmt.Errorf("db add webhook delivery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// webhookNews returns news payload of webhook.
+func webhookNews(news db.News) map[string]any {
+	state := State(news.StatusID).String()
+	if news.StatusID == db.StatusDeleted {
+		state = "deleted"
+	}
+
+	return map[string]any{
+		"newsId":      news.ID,
+		"categoryId":  news.CategoryID,
+		"title":       news.Title,
+		"content":     news.Content,
+		"author":      news.Author,
+		"publishedAt": news.PublishedAt,
+		"tagIds":      news.TagIDs,
+		"state":       state,
+	}
+}
@@ -0,0 +1,294 @@
+package newsportal
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver is a test webhook endpoint that records requests and responds with status.
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []webhookRequest
+}
+
+type webhookRequest struct {
+	Header  http.Header
+	Body    []byte
+	Payload WebhookPayload
+}
+
+func newWebhookReceiver(t *testing.T, status int) *webhookReceiver {
+	t.Helper()
+
+	wr := &webhookReceiver{status: status}
+	wr.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var payload WebhookPayload
+		_ = json.Unmarshal(body, &payload)
+
+		wr.mu.Lock()
+		defer wr.mu.Unlock()
+		wr.requests = append(wr.requests, webhookRequest{Header: r.Header.Clone(), Body: body, Payload: payload})
+		w.WriteHeader(wr.status)
+	}))
+	t.Cleanup(wr.Close)
+
+	return wr
+}
+
+func (wr *webhookReceiver) Requests() []webhookRequest {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+
+	return append([]webhookRequest(nil), wr.requests...)
+}
+
+func TestSignWebhook(t *testing.T) {
+	// echo -n '{"a":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=aa9e2e3575f5d7098b6caccd790888c36d5fdb63342a73bada2d6a51747a8494", SignWebhook("secret", []byte(`{"a":1}`)))
+	assert.NotEqual(t, SignWebhook("secret", []byte("body")), SignWebhook("other", []byte("body")))
+}
+
+func TestWebhookManager_backoff(t *testing.T) {
+	w := NewWebhookManager(nil, WebhookConfig{MinBackoff: time.Second, MaxBackoff: 10 * time.Second})
+
+	assert.Equal(t, time.Second, w.backoff(1))
+	assert.Equal(t, 2*time.Second, w.backoff(2))
+	assert.Equal(t, 8*time.Second, w.backoff(4))
+	assert.Equal(t, 10*time.Second, w.backoff(5))
+	assert.Equal(t, 10*time.Second, w.backoff(100))
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"93.184.215.14":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"0.0.0.0":          false,
+		"224.0.0.1":        false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, isPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestWebhookManager_deliver(t *testing.T) {
+	ctx := t.Context()
+	w := NewWebhookManager(nil, WebhookConfig{AllowPrivateNetworks: true})
+	delivery := db.WebhookDelivery{
+		ID:        7,
+		EventType: string(EventNewsPublished),
+		Payload:   map[string]any{"newsId": float64(1)},
+		CreatedAt: time.Now(),
+	}
+
+	t.Run("SendsSignedPayload", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusNoContent)
+		delivery.Webhook = &db.Webhook{URL: receiver.URL, Secret: "secret", StatusID: db.StatusEnabled}
+
+		require.NoError(t, w.deliver(ctx, delivery))
+
+		requests := receiver.Requests()
+		require.Len(t, requests, 1)
+		r := requests[0]
+		assert.Equal(t, SignWebhook("secret", r.Body), r.Header.Get(HeaderWebhookSignature))
+		assert.Equal(t, "news.published", r.Header.Get(HeaderWebhookEvent))
+		assert.Equal(t, "7", r.Header.Get(HeaderWebhookDelivery))
+		assert.Equal(t, 7, r.Payload.DeliveryID)
+		assert.Equal(t, EventNewsPublished, r.Payload.Event)
+		assert.Equal(t, delivery.Payload, r.Payload.News)
+	})
+
+	t.Run("FailsOnErrorStatus", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusInternalServerError)
+		delivery.Webhook = &db.Webhook{URL: receiver.URL, Secret: "secret", StatusID: db.StatusEnabled}
+
+		assert.ErrorContains(t, w.deliver(ctx, delivery), "unexpected status 500")
+	})
+
+	t.Run("DoesNotFollowRedirects", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		redirect := httptest.NewServer(http.RedirectHandler(receiver.URL, http.StatusTemporaryRedirect))
+		t.Cleanup(redirect.Close)
+		delivery.Webhook = &db.Webhook{URL: redirect.URL, Secret: "secret", StatusID: db.StatusEnabled}
+
+		assert.ErrorContains(t, w.deliver(ctx, delivery), "unexpected status 307")
+		assert.Empty(t, receiver.Requests())
+	})
+
+	t.Run("RefusesPrivateAddress", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		delivery.Webhook = &db.Webhook{URL: receiver.URL, Secret: "secret", StatusID: db.StatusEnabled}
+
+		assert.ErrorIs(t, NewWebhookManager(nil, WebhookConfig{}).deliver(ctx, delivery), errPrivateAddress)
+		assert.Empty(t, receiver.Requests())
+	})
+
+	t.Run("FailsForDisabledWebhook", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		delivery.Webhook = &db.Webhook{URL: receiver.URL, Secret: "secret", StatusID: db.StatusDisabled}
+
+		assert.Error(t, w.deliver(ctx, delivery))
+		assert.Empty(t, receiver.Requests())
+	})
+}
+
+func TestWebhookManager_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+	webhooks := NewWebhookManager(tx, WebhookConfig{MaxAttempts: 2, MinBackoff: time.Nanosecond, AllowPrivateNetworks: true})
+
+	t.Run("CreateValidatesInput", func(t *testing.T) {
+		_, err := webhooks.CreateWebhook(ctx, "ftp://example.com", []EventType{"news.unknown"}, "")
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.Webhook.URL])
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.Webhook.EventTypes])
+	})
+
+	t.Run("CreateRejectsPrivateAddress", func(t *testing.T) {
+		public := NewWebhookManager(tx, WebhookConfig{})
+		for _, u := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://169.254.169.254/latest", "http://[::1]/hook", "http://10.0.0.1/hook"} {
+			_, err := public.CreateWebhook(ctx, u, []EventType{EventNewsPublished}, "")
+
+			var vErr ValidationError
+			require.ErrorAs(t, err, &vErr, u)
+			assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.Webhook.URL], u)
+		}
+	})
+
+	t.Run("DeliversPublishedNews", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		webhook, err := webhooks.CreateWebhook(ctx, receiver.URL, []EventType{EventNewsPublished, EventNewsDeleted}, "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, webhook.Secret, "expected generated secret")
+
+		draft := createTestNews(t, tx, ctx, withStatusID(db.StatusInReview))
+		_, err = manager.TransitionNews(ctx, draft.ID, StatePublished)
+		require.NoError(t, err)
+		require.NoError(t, manager.DeleteNews(ctx, draft.ID))
+
+		sent, err := webhooks.DeliverWebhooks(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+
+		requests := receiver.Requests()
+		require.Len(t, requests, 2)
+		assert.Equal(t, []EventType{EventNewsPublished, EventNewsDeleted}, Map(requests, func(r webhookRequest) EventType { return r.Payload.Event }))
+		assert.Equal(t, float64(draft.ID), requests[0].Payload.News["newsId"])
+		assert.Equal(t, "deleted", requests[1].Payload.News["state"])
+		for _, r := range requests {
+			assert.Equal(t, SignWebhook(webhook.Secret, r.Body), r.Header.Get(HeaderWebhookSignature))
+		}
+
+		list, err := webhooks.WebhookDeliveries(ctx, webhook.ID, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		for _, d := range list {
+			assert.Equal(t, DeliveryDelivered, d.State)
+			assert.Equal(t, 1, d.Attempts)
+			assert.NotNil(t, d.DeliveredAt)
+		}
+	})
+
+	t.Run("SkipsNotPublicNews", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		webhook, err := webhooks.CreateWebhook(ctx, receiver.URL, []EventType{EventNewsPublished, EventNewsUpdated}, "secret")
+		require.NoError(t, err)
+
+		draft := createTestNews(t, tx, ctx, withStatusID(db.StatusDraft))
+		_, err = manager.TransitionNews(ctx, draft.ID, StateInReview)
+		require.NoError(t, err)
+
+		list, err := webhooks.WebhookDeliveries(ctx, webhook.ID, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+
+	t.Run("RetriesAndRedeliversDead", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusServiceUnavailable)
+		webhook, err := webhooks.CreateWebhook(ctx, receiver.URL, []EventType{EventNewsUpdated}, "secret")
+		require.NoError(t, err)
+
+		news := News{News: *createTestNews(t, tx, ctx)}
+		_, err = manager.UpdateNews(ctx, news)
+		require.NoError(t, err)
+
+		for range 2 {
+			_, err = webhooks.DeliverWebhooks(ctx)
+			require.NoError(t, err)
+		}
+		assert.Len(t, receiver.Requests(), 2)
+
+		dead := DeliveryDead
+		list, err := webhooks.WebhookDeliveries(ctx, webhook.ID, &dead, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, 2, list[0].Attempts)
+		require.NotNil(t, list[0].LastError)
+		assert.Contains(t, *list[0].LastError, "unexpected status 503")
+
+		n, err := webhooks.RedeliverWebhook(ctx, webhook.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		receiver.mu.Lock()
+		receiver.status = http.StatusOK
+		receiver.mu.Unlock()
+
+		_, err = webhooks.DeliverWebhooks(ctx)
+		require.NoError(t, err)
+
+		delivery, err := webhooks.WebhookDeliveries(ctx, webhook.ID, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, delivery, 1)
+		assert.Equal(t, DeliveryDelivered, delivery[0].State)
+	})
+
+	t.Run("DisabledWebhookDeliveriesAreDead", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusOK)
+		webhook, err := webhooks.CreateWebhook(ctx, receiver.URL, []EventType{EventNewsPublished}, "secret")
+		require.NoError(t, err)
+
+		draft := createTestNews(t, tx, ctx, withStatusID(db.StatusInReview))
+		_, err = manager.TransitionNews(ctx, draft.ID, StatePublished)
+		require.NoError(t, err)
+
+		require.NoError(t, webhooks.DisableWebhook(ctx, webhook.ID))
+		_, err = webhooks.DeliverWebhooks(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, receiver.Requests())
+
+		list, err := webhooks.WebhookDeliveries(ctx, webhook.ID, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, DeliveryDead, list[0].State)
+	})
+
+	t.Run("ReturnsNotFoundForUnknownWebhook", func(t *testing.T) {
+		assert.ErrorIs(t, webhooks.DisableWebhook(ctx, 99999), ErrNotFound)
+		_, err := webhooks.RedeliverWebhook(ctx, 99999, nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
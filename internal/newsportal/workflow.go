@@ -0,0 +1,256 @@
+package newsportal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/go-pg/pg/v10"
+)
+
+// ErrInvalidTransition is returned when news can't be moved from its current state to the requested one.
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// State is a news workflow state, it is stored as news statusId.
+// News go draft → in review → scheduled → published → archived, only published news are public.
+type State int
+
+const (
+	StateDraft     State = db.StatusDraft
+	StateInReview  State = db.StatusInReview
+	StateScheduled State = db.StatusScheduled
+	StatePublished State = db.StatusPublished
+	StateArchived  State = db.StatusArchived
+)
+
+// States are workflow states in workflow order.
+var States = []State{StateDraft, StateInReview, StateScheduled, StatePublished, StateArchived}
+
+var stateNames = map[State]string{
+	StateDraft:     "draft",
+	StateInReview:  "inReview",
+	StateScheduled: "scheduled",
+	StatePublished: "published",
+	StateArchived:  "archived",
+}
+
+// transitions are allowed target states of each state.
+var transitions = map[State][]State{
+	StateDraft:     {StateInReview, StateArchived},
+	StateInReview:  {StateDraft, StateScheduled, StatePublished},
+	StateScheduled: {StateDraft, StatePublished},
+	StatePublished: {StateArchived},
+	StateArchived:  {StateDraft, StatePublished},
+}
+
+// ParseState returns state by name.
+func ParseState(name string) (State, bool) {
+	for s, n := range stateNames {
+		if n == name {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+// String returns state name.
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// Valid reports whether state is known.
+func (s State) Valid() bool {
+	_, ok := stateNames[s]
+	return ok
+}
+
+// Public reports whether news in state are visible on the portal.
+func (s State) Public() bool {
+	return s == StatePublished
+}
+
+// Transitions returns states news can be moved to from state.
+func (s State) Transitions() []State {
+	return transitions[s]
+}
+
+// CanTransition reports whether news can be moved from state to target state.
+func (s State) CanTransition(to State) bool {
+	for _, t := range transitions[s] {
+		if t == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// publicStatusIDs returns statusId values of public states.
+func publicStatusIDs() []int {
+	var r []int
+	for _, s := range States {
+		if s.Public() {
+			r = append(r, int(s))
+		}
+	}
+
+	return r
+}
+
+// newsState returns state of news being saved. Empty status means draft, published news with
+// publishedAt in the future are scheduled.
+func newsState(news db.News, now time.Time) State {
+	state := State(news.StatusID)
+	switch {
+	case news.StatusID == 0:
+		return StateDraft
+	case state == StatePublished && news.PublishedAt.After(now):
+		return StateScheduled
+	}
+
+	return state
+}
+
+// validateState adds field errors of news state: state must be known and scheduled news must have publishedAt in the future.
+func validateState(fields map[string]string, state State, publishedAt, now time.Time) {
+	switch {
+	case !state.Valid():
+		fields[db.Columns.News.StatusID] = db.ErrWrongValue
+	case state == StateScheduled && !publishedAt.After(now):
+		fields[db.Columns.News.PublishedAt] = db.ErrWrongValue
+	}
+}
+
+// validateInitialState checks state of created news. News are created as drafts, scheduled and published news
+// can be created by admin only, other states must be reached by transitions.
+func validateInitialState(ctx context.Context, state State) error {
+	switch state {
+	case StateDraft:
+		return nil
+	case StateScheduled, StatePublished:
+		return checkStateRole(ctx, state)
+	}
+
+	return ValidationError{Fields: map[string]string{db.Columns.News.StatusID: db.ErrWrongValue}}
+}
+
+// checkTransition checks that news can be moved from state to target state by principal from context.
+// Returns ErrInvalidTransition for not allowed transition and ErrForbidden if principal role is not enough.
+func checkTransition(ctx context.Context, from, to State) error {
+	if !from.CanTransition(to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+
+	return checkStateRole(ctx, to)
+}
+
+// checkStateRole returns ErrForbidden if news are moved to scheduled or published state not by admin.
+// Context without principal is used by the app itself, e.g. by scheduler, API requests always have principal.
+func checkStateRole(ctx context.Context, to State) error {
+	if to != StateScheduled && to != StatePublished {
+		return nil
+	}
+
+	if p := PrincipalFromContext(ctx); p != nil && !p.Role.Allows(RoleAdmin) {
+		return fmt.Errorf("%w: only admin can move news to %s", ErrForbidden, to)
+	}
+
+	return nil
+}
+
+// TransitionNews moves news to state. Publishing sets publishedAt to now if it is in the future,
+// scheduling requires publishedAt in the future, only admin can schedule and publish news. Changes of public news
+// are sent to webhooks and news stream. Returns ErrNotFound for unknown or deleted news, ErrInvalidTransition
+// if transition is not allowed and ErrForbidden if principal role is not enough.
+func (u *Manager) TransitionNews(ctx context.Context, newsID int, to State) (*News, error) {
+	err := u.inTransaction(ctx, "", func(m *Manager, _ *pg.Tx) error {
+		existing, err := m.editRepo.NewsByID(ctx, newsID)
+		if err != nil {
+			return fmt.Errorf("db get news by id: %w", err)
+		} else if existing == nil {
+			return ErrNotFound
+		}
+
+		from := State(existing.StatusID)
+		if err := checkTransition(ctx, from, to); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		news := *existing
+		news.StatusID = int(to)
+		news.UpdatedAt = &now
+
+		switch {
+		case to == StatePublished && news.PublishedAt.After(now):
+			news.PublishedAt = now
+		case to == StateScheduled && !news.PublishedAt.After(now):
+			return ValidationError{Fields: map[string]string{db.Columns.News.PublishedAt: db.ErrWrongValue}}
+		}
+
+		_, err = m.editRepo.UpdateNews(ctx, &news, db.WithColumns(db.Columns.News.StatusID, db.Columns.News.PublishedAt, db.Columns.News.UpdatedAt))
+		if err != nil {
+			return fmt.Errorf("db update news: %w", err)
+		}
+
+		if err := m.recordNewsEvent(ctx, from, news); err != nil {
+			return err
+		}
+
+		return m.recordAudit(ctx, AuditEntityNews, newsID, AuditActionTransition, existing, news)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateNews()
+
+	news, err := u.NewsForEdit(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return news, nil
+}
+
+// PublishScheduledNews publishes scheduled news with publishedAt in the past, the oldest first.
+// News changed concurrently are skipped. Returns published news.
+func (u *Manager) PublishScheduledNews(ctx context.Context) ([]News, error) {
+	now := time.Now()
+	search := &db.NewsSearch{
+		StatusIDs:     []int{int(StateScheduled)},
+		PublishedAtLE: &now,
+	}
+
+	list, err := u.editRepo.NewsByFilters(ctx, search, db.PagerNoLimit, db.WithColumns(db.Columns.News.ID),
+		db.WithSort(db.NewSortField(db.Columns.News.PublishedAt, false), db.NewSortField(db.Columns.News.ID, false)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("db get scheduled news: %w", err)
+	}
+
+	var (
+		published []News
+		errs      []error
+	)
+	for _, n := range list {
+		news, err := u.TransitionNews(ctx, n.ID, StatePublished)
+		switch {
+		case errors.Is(err, ErrNotFound), errors.Is(err, ErrInvalidTransition):
+			continue
+		case err != nil:
+			errs = append(errs, fmt.Errorf("publish news %d: %w", n.ID, err))
+			continue
+		}
+
+		published = append(published, *news)
+	}
+
+	return published, errors.Join(errs...)
+}
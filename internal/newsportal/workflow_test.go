@@ -0,0 +1,222 @@
+package newsportal
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState(t *testing.T) {
+	for _, s := range States {
+		parsed, ok := ParseState(s.String())
+		require.True(t, ok, "expected %s to be parsed", s)
+		assert.Equal(t, s, parsed)
+	}
+
+	_, ok := ParseState("deleted")
+	assert.False(t, ok)
+	assert.False(t, State(db.StatusDeleted).Valid())
+	assert.Equal(t, []int{db.StatusPublished}, publicStatusIDs())
+
+	assert.True(t, StateDraft.CanTransition(StateInReview))
+	assert.True(t, StateInReview.CanTransition(StateScheduled))
+	assert.True(t, StateScheduled.CanTransition(StatePublished))
+	assert.True(t, StatePublished.CanTransition(StateArchived))
+	assert.False(t, StateDraft.CanTransition(StatePublished), "expected draft to be reviewed before publishing")
+	assert.False(t, StatePublished.CanTransition(StateDraft))
+}
+
+func TestNewsState(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, StateDraft, newsState(db.News{}, now))
+	assert.Equal(t, StatePublished, newsState(db.News{StatusID: db.StatusPublished, PublishedAt: now.Add(-time.Hour)}, now))
+	assert.Equal(t, StateScheduled, newsState(db.News{StatusID: db.StatusPublished, PublishedAt: now.Add(time.Hour)}, now))
+	assert.Equal(t, StateInReview, newsState(db.News{StatusID: db.StatusInReview, PublishedAt: now.Add(time.Hour)}, now))
+
+	fields := map[string]string{}
+	validateState(fields, StateScheduled, now.Add(-time.Hour), now)
+	assert.Equal(t, db.ErrWrongValue, fields[db.Columns.News.PublishedAt])
+
+	fields = map[string]string{}
+	validateState(fields, State(db.StatusDisabled), now, now)
+	assert.Equal(t, db.ErrWrongValue, fields[db.Columns.News.StatusID])
+}
+
+func TestNewsEventType(t *testing.T) {
+	for _, tc := range []struct {
+		from, to State
+		want     EventType
+	}{
+		{StateScheduled, StatePublished, EventNewsPublished},
+		{StatePublished, StatePublished, EventNewsUpdated},
+		{StatePublished, StateArchived, EventNewsDeleted},
+	} {
+		got, ok := newsEventType(tc.from, tc.to)
+		assert.True(t, ok, "%s to %s", tc.from, tc.to)
+		assert.Equal(t, tc.want, got, "%s to %s", tc.from, tc.to)
+	}
+
+	_, ok := newsEventType(StateDraft, StateInReview)
+	assert.False(t, ok, "expected no event for changes of not public news")
+}
+
+func TestManager_TransitionNews_Integration(t *testing.T) {
+	tx, ctx, manager := withTx(t)
+
+	t.Run("ReviewAndPublish", func(t *testing.T) {
+		draft := createTestNews(t, tx, ctx, withStatusID(db.StatusDraft), withPublishedAt(time.Now().Add(time.Hour)))
+
+		got, err := manager.NewsByID(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got, "draft should not be visible")
+
+		_, err = manager.TransitionNews(ctx, draft.ID, StatePublished)
+		assert.ErrorIs(t, err, ErrInvalidTransition)
+
+		news, err := manager.TransitionNews(ctx, draft.ID, StateInReview)
+		require.NoError(t, err)
+		assert.Equal(t, StateInReview, news.State())
+
+		news, err = manager.TransitionNews(ctx, draft.ID, StatePublished)
+		require.NoError(t, err)
+		assert.Equal(t, StatePublished, news.State())
+		assert.False(t, news.PublishedAt.After(time.Now()), "expected publishedAt to be set to now")
+
+		got, err = manager.NewsByID(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, got, "published news should be visible")
+
+		entity := AuditEntityNews
+		list, err := manager.AuditLog(ctx, AuditLogFilter{Entity: &entity, EntityID: &draft.ID}, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, AuditActionTransition, list[0].Action)
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		past := createTestNews(t, tx, ctx, withStatusID(db.StatusInReview))
+		_, err := manager.TransitionNews(ctx, past.ID, StateScheduled)
+
+		var vErr ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.News.PublishedAt])
+
+		future := createTestNews(t, tx, ctx, withStatusID(db.StatusInReview), withPublishedAt(time.Now().Add(time.Hour)))
+		news, err := manager.TransitionNews(ctx, future.ID, StateScheduled)
+		require.NoError(t, err)
+		assert.Equal(t, StateScheduled, news.State())
+	})
+
+	t.Run("UpdateChecksTransition", func(t *testing.T) {
+		in := News{News: *createTestNews(t, tx, ctx)}
+		in.StatusID = db.StatusDraft
+
+		_, err := manager.UpdateNews(ctx, in)
+		assert.ErrorIs(t, err, ErrInvalidTransition)
+
+		in.StatusID = 0
+		updated, err := manager.UpdateNews(ctx, in)
+		require.NoError(t, err)
+		assert.Equal(t, StatePublished, updated.State(), "expected state to be kept")
+	})
+
+	t.Run("CreateAsDraft", func(t *testing.T) {
+		content := "Draft content"
+		created, err := manager.CreateNews(ctx, News{News: db.News{
+			CategoryID:  1,
+			Title:       "Draft",
+			Content:     &content,
+			Author:      "Editor",
+			PublishedAt: db.BaseTime,
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, StateDraft, created.State())
+	})
+
+	t.Run("CreateChecksInitialState", func(t *testing.T) {
+		newNews := func(statusID int) News {
+			return News{News: db.News{CategoryID: 1, Title: "Initial state", Author: "Editor", PublishedAt: db.BaseTime, StatusID: statusID}}
+		}
+		editorCtx := WithPrincipal(ctx, &Principal{Subject: "editor", Role: RoleEditor})
+		adminCtx := WithPrincipal(ctx, &Principal{Subject: "admin", Role: RoleAdmin})
+
+		for _, statusID := range []int{db.StatusInReview, db.StatusArchived} {
+			_, err := manager.CreateNews(adminCtx, newNews(statusID))
+			var vErr ValidationError
+			require.ErrorAs(t, err, &vErr, "status %d", statusID)
+			assert.Equal(t, db.ErrWrongValue, vErr.Fields[db.Columns.News.StatusID])
+		}
+
+		_, err := manager.CreateNews(editorCtx, newNews(db.StatusPublished))
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		created, err := manager.CreateNews(editorCtx, newNews(db.StatusDraft))
+		require.NoError(t, err)
+		assert.Equal(t, StateDraft, created.State())
+
+		created, err = manager.CreateNews(adminCtx, newNews(db.StatusPublished))
+		require.NoError(t, err)
+		assert.Equal(t, StatePublished, created.State())
+	})
+
+	t.Run("PublishingRequiresAdmin", func(t *testing.T) {
+		editorCtx := WithPrincipal(ctx, &Principal{Subject: "editor", Role: RoleEditor})
+		adminCtx := WithPrincipal(ctx, &Principal{Subject: "admin", Role: RoleAdmin})
+
+		inReview := createTestNews(t, tx, ctx, withStatusID(db.StatusInReview))
+		_, err := manager.TransitionNews(editorCtx, inReview.ID, StatePublished)
+		assert.ErrorIs(t, err, ErrForbidden, "editor can't publish by transition")
+
+		in := News{News: *inReview}
+		in.StatusID = db.StatusPublished
+		_, err = manager.UpdateNews(editorCtx, in)
+		assert.ErrorIs(t, err, ErrForbidden, "editor can't publish by update")
+
+		archived := createTestNews(t, tx, ctx, withStatusID(db.StatusArchived))
+		_, err = manager.TransitionNews(editorCtx, archived.ID, StatePublished)
+		assert.ErrorIs(t, err, ErrForbidden, "editor can't republish archived news")
+
+		in.PublishedAt = time.Now().Add(time.Hour)
+		in.StatusID = db.StatusScheduled
+		_, err = manager.UpdateNews(editorCtx, in)
+		assert.ErrorIs(t, err, ErrForbidden, "editor can't schedule by update")
+
+		moved, err := manager.TransitionNews(editorCtx, archived.ID, StateDraft)
+		require.NoError(t, err, "editor can move news to other states")
+		assert.Equal(t, StateDraft, moved.State())
+
+		published, err := manager.TransitionNews(adminCtx, inReview.ID, StatePublished)
+		require.NoError(t, err)
+		assert.Equal(t, StatePublished, published.State())
+	})
+
+	t.Run("ReturnsNotFoundForUnknownNews", func(t *testing.T) {
+		_, err := manager.TransitionNews(ctx, 99999, StateArchived)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("PublishScheduledNews", func(t *testing.T) {
+		due := createTestNews(t, tx, ctx, withStatusID(db.StatusScheduled), withPublishedAt(time.Now().Add(-time.Minute)))
+		future := createTestNews(t, tx, ctx, withStatusID(db.StatusScheduled), withPublishedAt(time.Now().Add(time.Hour)))
+
+		published, err := manager.PublishScheduledNews(ctx)
+		require.NoError(t, err)
+
+		ids := Map(published, func(n News) int { return n.ID })
+		assert.Contains(t, ids, due.ID)
+		assert.NotContains(t, ids, future.ID)
+
+		got, err := manager.NewsForEdit(ctx, due.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatePublished, got.State())
+		assert.True(t, got.PublishedAt.Before(time.Now()), "expected publishedAt to be kept")
+
+		got, err = manager.NewsForEdit(ctx, future.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StateScheduled, got.State())
+	})
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/labstack/echo/v4"
+)
+
+// Authenticate returns middleware that puts principal from X-API-Key or Authorization: Bearer headers
+// into request context. Responds 401 for invalid credentials. Without enabled auth requests are passed as is.
+func Authenticate(auth *newsportal.AuthManager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !auth.Enabled() {
+				return next(c)
+			}
+
+			r := c.Request()
+			p, err := auth.AuthenticateRequest(r.Context(), r)
+			if err != nil {
+				return authError(c, err)
+			} else if p != nil {
+				c.SetRequest(r.WithContext(newsportal.WithPrincipal(r.Context(), p)))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireRole returns middleware that checks principal role. Responds 401 without principal and 403 for insufficient role.
+// Requests are passed as is with empty role, without enabled auth requests with non-empty role get 403.
+func RequireRole(auth *newsportal.AuthManager, role newsportal.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if role != "" && !auth.Enabled() {
+				return authError(c, newsportal.ErrAuthDisabled)
+			}
+
+			if err := newsportal.RequireRole(c.Request().Context(), role); err != nil {
+				return authError(c, err)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// authError writes 401 or 403 response for authentication error, other errors are returned as is.
+func authError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, newsportal.ErrUnauthorized):
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	case errors.Is(err, newsportal.ErrForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandler_Auth_Integration(t *testing.T) {
+	auth := newsportal.NewAuthManager(testDB, newsportal.AuthConfig{Enabled: true, JWTSecret: "test-secret"})
+	e := NewNewsHandler(testHandler.uc, testHandler.log).WithAuth(auth).RegisterRoutes()
+
+	token := func(role newsportal.Role) string {
+		token, err := auth.NewToken("test", role, time.Minute)
+		require.NoError(t, err)
+		return token
+	}
+
+	do := func(method, target, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("PublicReads", func(t *testing.T) {
+		rec := do(http.MethodGet, "/api/v1/categories", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		rec := do(http.MethodGet, "/api/v1/categories", "invalid")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("WriteWithoutToken", func(t *testing.T) {
+		rec := do(http.MethodPost, "/api/v1/tags", "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("WriteWithReaderRole", func(t *testing.T) {
+		rec := do(http.MethodPost, "/api/v1/tags", token(newsportal.RoleReader))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("WriteWithEditorRole", func(t *testing.T) {
+		rec := do(http.MethodPost, "/api/v1/tags", token(newsportal.RoleEditor))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, "expected validation error after auth, body: %s", rec.Body.String())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		disabled := newsportal.NewAuthManager(testDB, newsportal.AuthConfig{ReadRole: newsportal.RoleReader})
+		e := NewNewsHandler(testHandler.uc, testHandler.log).WithAuth(disabled).RegisterRoutes()
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/categories", nil))
+		assert.Equal(t, http.StatusOK, rec.Code, "reads are public without auth")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/tags", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code, "writes are forbidden without auth")
+	})
+
+	t.Run("ReadRole", func(t *testing.T) {
+		private := newsportal.NewAuthManager(testDB, newsportal.AuthConfig{
+			Enabled:   true,
+			JWTSecret: "test-secret",
+			ReadRole:  newsportal.RoleReader,
+		})
+		e := NewNewsHandler(testHandler.uc, testHandler.log).WithAuth(private).RegisterRoutes()
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/categories", nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/categories", nil)
+		req.Header.Set("Authorization", "Bearer "+token(newsportal.RoleReader))
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,39 @@
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BodyLimit returns middleware that rejects requests with body larger than limit bytes with 413.
+// Body is read through http.MaxBytesReader before handler and restored, so handler and other middlewares
+// never buffer more than limit.
+func BodyLimit(limit int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			if r.ContentLength > limit {
+				return echo.ErrStatusRequestEntityTooLarge
+			}
+
+			if r.Body == nil || r.Body == http.NoBody {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), r.Body, limit))
+			if err != nil {
+				if mbErr := new(http.MaxBytesError); errors.As(err, &mbErr) {
+					return echo.ErrStatusRequestEntityTooLarge
+				}
+				return echo.NewHTTPError(http.StatusBadRequest, "read request body").SetInternal(err)
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			return next(c)
+		}
+	}
+}
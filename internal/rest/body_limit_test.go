@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBodyLimit(t *testing.T) {
+	e := echo.New()
+	e.POST("/", func(c echo.Context) error {
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, string(body))
+	}, BodyLimit(4))
+
+	do := func(body io.Reader, contentLength int64) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.ContentLength = contentLength
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		rec := do(strings.NewReader("body"), 4)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "body", rec.Body.String())
+	})
+
+	t.Run("ContentLengthTooLarge", func(t *testing.T) {
+		rec := do(strings.NewReader("large"), 5)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("ChunkedTooLarge", func(t *testing.T) {
+		rec := do(strings.NewReader("large"), -1)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
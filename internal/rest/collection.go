@@ -1,8 +1,9 @@
 package rest
 
 //go:generate colgen -imports=github.com/daniilsolovey/news-portal/internal/newsportal -funcpkg=newsportal
-//colgen:News,Tag,Category,NewsSummary
+//colgen:News,Tag,Category,NewsSummary,NewsRevision
 //colgen:News:Map(newsportal),Index(NewsID)
 //colgen:Category:Map(newsportal),Index(CategoryID)
 //colgen:Tag:Map(newsportal),Index(TagID)
 //colgen:NewsSummary:Map(newsportal.News)
+//colgen:NewsRevision:Map(newsportal)
@@ -29,6 +29,12 @@ func (ll NewsList) IndexByNewsID() map[int]News {
 	return r
 }
 
+type NewsRevisions []NewsRevision
+
+func NewNewsRevisions(in []newsportal.NewsRevision) NewsRevisions {
+	return newsportal.Map(in, NewNewsRevision)
+}
+
 type NewsSummaries []NewsSummary
 
 func NewNewsSummaries(in []newsportal.News) NewsSummaries { return newsportal.Map(in, NewNewsSummary) }
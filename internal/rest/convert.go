@@ -1,6 +1,9 @@
 package rest
 
-import "github.com/daniilsolovey/news-portal/internal/newsportal"
+import (
+	"github.com/daniilsolovey/news-portal/internal/db"
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
 
 func NewNews(n newsportal.News) News {
 	news := News{
@@ -10,6 +13,7 @@ func NewNews(n newsportal.News) News {
 		Content:     *n.Content,
 		Author:      n.Author,
 		PublishedAt: n.PublishedAt,
+		State:       n.State().String(),
 		Category:    NewCategory(n.Category),
 		Tags:        NewTags(n.Tags),
 	}
@@ -26,6 +30,7 @@ func NewNewsSummary(n newsportal.News) NewsSummary {
 		PublishedAt: n.PublishedAt,
 		Category:    NewCategory(n.Category),
 		Tags:        NewTags(n.Tags),
+		Snippet:     n.Snippet,
 	}
 
 	return summary
@@ -33,8 +38,10 @@ func NewNewsSummary(n newsportal.News) NewsSummary {
 
 func NewCategory(c newsportal.Category) Category {
 	return Category{
-		CategoryID: c.ID,
-		Title:      c.Title,
+		CategoryID:  c.ID,
+		Title:       c.Title,
+		OrderNumber: c.OrderNumber,
+		StatusID:    c.StatusID,
 	}
 }
 
@@ -45,3 +52,138 @@ func NewTag(t newsportal.Tag) Tag {
 		StatusID: t.StatusID,
 	}
 }
+
+func (n NewsInput) ToModel() newsportal.News {
+	tagIDs := n.TagIDs
+	if tagIDs == nil {
+		tagIDs = []int{}
+	}
+
+	return newsportal.NewNews(db.News{
+		CategoryID:  n.CategoryID,
+		Title:       n.Title,
+		Content:     &n.Content,
+		Author:      n.Author,
+		PublishedAt: n.PublishedAt,
+		TagIDs:      tagIDs,
+		StatusID:    n.StatusID,
+	})
+}
+
+// Apply sets present patch fields to news.
+func (p NewsPatch) Apply(news *newsportal.News) {
+	if p.CategoryID != nil {
+		news.CategoryID = *p.CategoryID
+	}
+	if p.Title != nil {
+		news.Title = *p.Title
+	}
+	if p.Content != nil {
+		news.Content = p.Content
+	}
+	if p.Author != nil {
+		news.Author = *p.Author
+	}
+	if p.PublishedAt != nil {
+		news.PublishedAt = *p.PublishedAt
+	}
+	if p.TagIDs != nil {
+		news.TagIDs = *p.TagIDs
+	}
+	if p.StatusID != nil {
+		news.StatusID = *p.StatusID
+	}
+}
+
+func (c CategoryInput) ToModel() newsportal.Category {
+	return newsportal.NewCategory(db.Category{
+		Title:       c.Title,
+		OrderNumber: c.OrderNumber,
+		StatusID:    c.StatusID,
+	})
+}
+
+// Apply sets present patch fields to category.
+func (p CategoryPatch) Apply(category *newsportal.Category) {
+	if p.Title != nil {
+		category.Title = *p.Title
+	}
+	if p.OrderNumber != nil {
+		category.OrderNumber = *p.OrderNumber
+	}
+	if p.StatusID != nil {
+		category.Category.StatusID = *p.StatusID
+	}
+}
+
+func (t TagInput) ToModel() newsportal.Tag {
+	return newsportal.NewTag(db.Tag{
+		Title:    t.Title,
+		StatusID: t.StatusID,
+	})
+}
+
+// Apply sets present patch fields to tag.
+func (p TagPatch) Apply(tag *newsportal.Tag) {
+	if p.Title != nil {
+		tag.Title = *p.Title
+	}
+	if p.StatusID != nil {
+		tag.Tag.StatusID = *p.StatusID
+	}
+}
+
+func NewNewsPage(p newsportal.NewsPage) NewsPage {
+	return NewsPage{
+		Items:    NewNewsSummaries(p.Items),
+		Total:    p.Total,
+		Page:     p.Page,
+		PageSize: p.PageSize,
+		HasNext:  p.HasNext,
+	}
+}
+
+func NewNewsCursorPage(list []newsportal.News, next string) NewsCursorPage {
+	page := NewsCursorPage{Items: NewNewsSummaries(list)}
+	if next != "" {
+		page.NextCursor = &next
+	}
+
+	return page
+}
+
+func NewNewsRevision(r newsportal.NewsRevision) NewsRevision {
+	revision := NewsRevision{
+		NewsRevisionID: r.ID,
+		NewsID:         r.NewsID,
+		CategoryID:     r.CategoryID,
+		Title:          r.Title,
+		TagIDs:         r.TagIDs,
+		Actor:          r.Actor,
+		CreatedAt:      r.CreatedAt,
+	}
+
+	if r.Content != nil {
+		revision.Content = *r.Content
+	}
+
+	return revision
+}
+
+func NewNewsRevisionDiff(d newsportal.NewsRevisionDiff) NewsRevisionDiff {
+	fields := make(map[string]Change, len(d.Fields))
+	for field, change := range d.Fields {
+		fields[field] = Change{Old: change.Old, New: change.New}
+	}
+
+	return NewsRevisionDiff{
+		From:    NewNewsRevision(d.From),
+		To:      NewNewsRevision(d.To),
+		Fields:  fields,
+		Content: newsportal.Map(d.Content, NewDiffLine),
+	}
+}
+
+func NewDiffLine(l newsportal.DiffLine) DiffLine {
+	return DiffLine{Op: string(l.Op), Text: l.Text}
+}
@@ -0,0 +1,437 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/labstack/echo/v4"
+)
+
+// handleEditError maps newsportal write errors to 422, 404, 403, 409 or 500 responses.
+func (h *NewsHandler) handleEditError(c echo.Context, err error, notFound string) error {
+	var vErr newsportal.ValidationError
+	switch {
+	case errors.As(err, &vErr):
+		return c.JSON(http.StatusUnprocessableEntity, vErr.Fields)
+	case errors.Is(err, newsportal.ErrNotFound):
+		return h.handleError(c, err, http.StatusNotFound, notFound)
+	case errors.Is(err, newsportal.ErrInvalidTransition):
+		return h.handleError(c, err, http.StatusConflict, "transition is not allowed")
+	case errors.Is(err, newsportal.ErrForbidden):
+		return h.handleError(c, err, http.StatusForbidden, "forbidden")
+	}
+
+	return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+}
+
+// pathID returns positive id from path.
+func pathID(c echo.Context) (int, error) {
+	return pathParamID(c, "id")
+}
+
+// pathParamID returns positive id from path param.
+func pathParamID(c echo.Context, name string) (int, error) {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil {
+		return 0, err
+	} else if id <= 0 {
+		return 0, errors.New("id must be positive")
+	}
+
+	return id, nil
+}
+
+// created sets Location header and writes 201 response.
+func created(c echo.Context, location string, body any) error {
+	c.Response().Header().Set(echo.HeaderLocation, location)
+	return c.JSON(http.StatusCreated, body)
+}
+
+// CreateNews handles POST /api/v1/news
+// @Summary Create news
+// @Description Creates news item. Category and tags must exist and be enabled. News are created as drafts, only admin can create scheduled and published news
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param news body rest.NewsInput true "News data"
+// @Success 201 {object} rest.News
+// @Header 201 {string} Location "URL of created news"
+// @Failure 400,403,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/news [post]
+func (h *NewsHandler) CreateNews(c echo.Context) error {
+	var req NewsInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	news, err := h.uc.CreateNews(c.Request().Context(), req.ToModel())
+	if err != nil {
+		return h.handleEditError(c, err, "news not found")
+	}
+
+	return created(c, fmt.Sprintf("/api/v1/news/%d", news.ID), NewNews(*news))
+}
+
+// UpdateNews handles PUT /api/v1/news/:id
+// @Summary Update news
+// @Description Replaces all editable fields of news item
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "News ID"
+// @Param news body rest.NewsInput true "News data"
+// @Success 200 {object} rest.News
+// @Failure 400,403,404,409,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/news/{id} [put]
+func (h *NewsHandler) UpdateNews(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req NewsInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	in := req.ToModel()
+	in.ID = id
+
+	news, err := h.uc.UpdateNews(c.Request().Context(), in)
+	if err != nil {
+		return h.handleEditError(c, err, "news not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNews(*news))
+}
+
+// PatchNews handles PATCH /api/v1/news/:id
+// @Summary Patch news
+// @Description Updates only present fields of news item. News is locked while patch is applied, so concurrent patches are not lost
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "News ID"
+// @Param news body rest.NewsPatch true "News fields"
+// @Success 200 {object} rest.News
+// @Failure 400,403,404,409,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/news/{id} [patch]
+func (h *NewsHandler) PatchNews(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req NewsPatch
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	news, err := h.uc.PatchNews(c.Request().Context(), id, req.Apply)
+	if err != nil {
+		return h.handleEditError(c, err, "news not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNews(*news))
+}
+
+// DeleteNews handles DELETE /api/v1/news/:id
+// @Summary Delete news
+// @Description Marks news item as deleted
+// @Tags news
+// @Param id path int true "News ID"
+// @Success 204
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/news/{id} [delete]
+func (h *NewsHandler) DeleteNews(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.uc.DeleteNews(c.Request().Context(), id); err != nil {
+		return h.handleEditError(c, err, "news not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CategoryByID handles GET /api/v1/categories/:id
+// @Summary Get category by ID
+// @Description Retrieves a single enabled category by ID
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} rest.Category
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/categories/{id} [get]
+func (h *NewsHandler) CategoryByID(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	category, err := h.uc.CategoryByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	} else if category == nil {
+		return h.handleError(c, nil, http.StatusNotFound, "category not found")
+	}
+
+	return c.JSON(http.StatusOK, NewCategory(*category))
+}
+
+// CreateCategory handles POST /api/v1/categories
+// @Summary Create category
+// @Description Creates category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body rest.CategoryInput true "Category data"
+// @Success 201 {object} rest.Category
+// @Header 201 {string} Location "URL of created category"
+// @Failure 400,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/categories [post]
+func (h *NewsHandler) CreateCategory(c echo.Context) error {
+	var req CategoryInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	category, err := h.uc.CreateCategory(c.Request().Context(), req.ToModel())
+	if err != nil {
+		return h.handleEditError(c, err, "category not found")
+	}
+
+	return created(c, fmt.Sprintf("/api/v1/categories/%d", category.ID), NewCategory(*category))
+}
+
+// UpdateCategory handles PUT /api/v1/categories/:id
+// @Summary Update category
+// @Description Replaces all editable fields of category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param category body rest.CategoryInput true "Category data"
+// @Success 200 {object} rest.Category
+// @Failure 400,404,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/categories/{id} [put]
+func (h *NewsHandler) UpdateCategory(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req CategoryInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	in := req.ToModel()
+	in.ID = id
+
+	category, err := h.uc.UpdateCategory(c.Request().Context(), in)
+	if err != nil {
+		return h.handleEditError(c, err, "category not found")
+	}
+
+	return c.JSON(http.StatusOK, NewCategory(*category))
+}
+
+// PatchCategory handles PATCH /api/v1/categories/:id
+// @Summary Patch category
+// @Description Updates only present fields of category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param category body rest.CategoryPatch true "Category fields"
+// @Success 200 {object} rest.Category
+// @Failure 400,404,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/categories/{id} [patch]
+func (h *NewsHandler) PatchCategory(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req CategoryPatch
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	category, err := h.uc.PatchCategory(c.Request().Context(), id, req.Apply)
+	if err != nil {
+		return h.handleEditError(c, err, "category not found")
+	}
+
+	return c.JSON(http.StatusOK, NewCategory(*category))
+}
+
+// DeleteCategory handles DELETE /api/v1/categories/:id
+// @Summary Delete category
+// @Description Marks category as deleted
+// @Tags categories
+// @Param id path int true "Category ID"
+// @Success 204
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/categories/{id} [delete]
+func (h *NewsHandler) DeleteCategory(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.uc.DeleteCategory(c.Request().Context(), id); err != nil {
+		return h.handleEditError(c, err, "category not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// TagByID handles GET /api/v1/tags/:id
+// @Summary Get tag by ID
+// @Description Retrieves a single enabled tag by ID
+// @Tags tags
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} rest.Tag
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/tags/{id} [get]
+func (h *NewsHandler) TagByID(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	tag, err := h.uc.TagByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	} else if tag == nil {
+		return h.handleError(c, nil, http.StatusNotFound, "tag not found")
+	}
+
+	return c.JSON(http.StatusOK, NewTag(*tag))
+}
+
+// CreateTag handles POST /api/v1/tags
+// @Summary Create tag
+// @Description Creates tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body rest.TagInput true "Tag data"
+// @Success 201 {object} rest.Tag
+// @Header 201 {string} Location "URL of created tag"
+// @Failure 400,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/tags [post]
+func (h *NewsHandler) CreateTag(c echo.Context) error {
+	var req TagInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	tag, err := h.uc.CreateTag(c.Request().Context(), req.ToModel())
+	if err != nil {
+		return h.handleEditError(c, err, "tag not found")
+	}
+
+	return created(c, fmt.Sprintf("/api/v1/tags/%d", tag.ID), NewTag(*tag))
+}
+
+// UpdateTag handles PUT /api/v1/tags/:id
+// @Summary Update tag
+// @Description Replaces all editable fields of tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param tag body rest.TagInput true "Tag data"
+// @Success 200 {object} rest.Tag
+// @Failure 400,404,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/tags/{id} [put]
+func (h *NewsHandler) UpdateTag(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req TagInput
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	in := req.ToModel()
+	in.ID = id
+
+	tag, err := h.uc.UpdateTag(c.Request().Context(), in)
+	if err != nil {
+		return h.handleEditError(c, err, "tag not found")
+	}
+
+	return c.JSON(http.StatusOK, NewTag(*tag))
+}
+
+// PatchTag handles PATCH /api/v1/tags/:id
+// @Summary Patch tag
+// @Description Updates only present fields of tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param tag body rest.TagPatch true "Tag fields"
+// @Success 200 {object} rest.Tag
+// @Failure 400,404,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/tags/{id} [patch]
+func (h *NewsHandler) PatchTag(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req TagPatch
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request body")
+	}
+
+	tag, err := h.uc.PatchTag(c.Request().Context(), id, req.Apply)
+	if err != nil {
+		return h.handleEditError(c, err, "tag not found")
+	}
+
+	return c.JSON(http.StatusOK, NewTag(*tag))
+}
+
+// DeleteTag handles DELETE /api/v1/tags/:id
+// @Summary Delete tag
+// @Description Marks tag as deleted
+// @Tags tags
+// @Param id path int true "Tag ID"
+// @Success 204
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/tags/{id} [delete]
+func (h *NewsHandler) DeleteTag(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.uc.DeleteTag(c.Request().Context(), id); err != nil {
+		return h.handleEditError(c, err, "tag not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
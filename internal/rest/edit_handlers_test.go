@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doJSON sends request with JSON body as admin.
+func doJSON(t *testing.T, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	token, err := testAuth.NewToken("admin", newsportal.RoleAdmin, time.Minute)
+	require.NoError(t, err)
+
+	e := testHandler.RegisterRoutes()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestNewsHandler_NewsCRUD_Integration(t *testing.T) {
+	body := `{"categoryId":1,"title":"REST News","content":"REST content","author":"Editor","publishedAt":"2024-01-10T12:00:00Z","tagIds":[1,2],"statusId":1}`
+
+	var news News
+	t.Run("CreateReturnsCreatedWithLocation", func(t *testing.T) {
+		rec := doJSON(t, http.MethodPost, "/api/v1/news", body)
+		require.Equal(t, http.StatusCreated, rec.Code, "expected status 201, body: %s", rec.Body.String())
+
+		err := json.Unmarshal(rec.Body.Bytes(), &news)
+		require.NoError(t, err, "failed to unmarshal response")
+		assert.NotZero(t, news.NewsID, "invalid NewsID")
+		assert.Equal(t, "REST News", news.Title)
+		assert.Len(t, news.Tags, 2, "expected tags to be attached")
+		assert.Equal(t, fmt.Sprintf("/api/v1/news/%d", news.NewsID), rec.Header().Get("Location"))
+	})
+
+	t.Run("CreateReturnsFieldErrors", func(t *testing.T) {
+		rec := doJSON(t, http.MethodPost, "/api/v1/news", `{"categoryId":99999,"title":"","author":"Editor","publishedAt":"2024-01-10T12:00:00Z","statusId":1}`)
+		require.Equal(t, http.StatusUnprocessableEntity, rec.Code, "expected status 422, body: %s", rec.Body.String())
+
+		var fields map[string]string
+		err := json.Unmarshal(rec.Body.Bytes(), &fields)
+		require.NoError(t, err, "failed to unmarshal response")
+		assert.Equal(t, "empty", fields["title"])
+		assert.Equal(t, "value", fields["categoryId"])
+	})
+
+	t.Run("UpdateReplacesNews", func(t *testing.T) {
+		require.NotZero(t, news.NewsID, "news was not created")
+		rec := doJSON(t, http.MethodPut, fmt.Sprintf("/api/v1/news/%d", news.NewsID), strings.Replace(body, "REST News", "REST News Updated", 1))
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var updated News
+		err := json.Unmarshal(rec.Body.Bytes(), &updated)
+		require.NoError(t, err, "failed to unmarshal response")
+		assert.Equal(t, "REST News Updated", updated.Title)
+	})
+
+	t.Run("PatchUpdatesOnlyPresentFields", func(t *testing.T) {
+		require.NotZero(t, news.NewsID, "news was not created")
+		rec := doJSON(t, http.MethodPatch, fmt.Sprintf("/api/v1/news/%d", news.NewsID), `{"author":"Patched Author"}`)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var patched News
+		err := json.Unmarshal(rec.Body.Bytes(), &patched)
+		require.NoError(t, err, "failed to unmarshal response")
+		assert.Equal(t, "Patched Author", patched.Author)
+		assert.Equal(t, "REST News Updated", patched.Title)
+	})
+
+	t.Run("DeleteReturnsNoContent", func(t *testing.T) {
+		require.NotZero(t, news.NewsID, "news was not created")
+		rec := doJSON(t, http.MethodDelete, fmt.Sprintf("/api/v1/news/%d", news.NewsID), "")
+		require.Equal(t, http.StatusNoContent, rec.Code, "expected status 204, body: %s", rec.Body.String())
+
+		rec = doJSON(t, http.MethodDelete, fmt.Sprintf("/api/v1/news/%d", news.NewsID), "")
+		require.Equal(t, http.StatusNotFound, rec.Code, "expected status 404 for deleted news")
+	})
+
+	t.Run("UpdateUnknownReturnsNotFound", func(t *testing.T) {
+		rec := doJSON(t, http.MethodPut, "/api/v1/news/99999", body)
+		require.Equal(t, http.StatusNotFound, rec.Code, "expected status 404, body: %s", rec.Body.String())
+	})
+
+	t.Run("InvalidBodyReturnsBadRequest", func(t *testing.T) {
+		rec := doJSON(t, http.MethodPost, "/api/v1/news", `{"title":`)
+		require.Equal(t, http.StatusBadRequest, rec.Code, "expected status 400")
+	})
+}
+
+func TestNewsHandler_CategoryCRUD_Integration(t *testing.T) {
+	rec := doJSON(t, http.MethodPost, "/api/v1/categories", `{"title":"REST Category","orderNumber":10,"statusId":1}`)
+	require.Equal(t, http.StatusCreated, rec.Code, "expected status 201, body: %s", rec.Body.String())
+
+	var category Category
+	err := json.Unmarshal(rec.Body.Bytes(), &category)
+	require.NoError(t, err, "failed to unmarshal response")
+	location := fmt.Sprintf("/api/v1/categories/%d", category.CategoryID)
+	assert.Equal(t, location, rec.Header().Get("Location"))
+
+	rec = doJSON(t, http.MethodGet, location, "")
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+	rec = doJSON(t, http.MethodPatch, location, `{"title":"`+strings.Repeat("x", 256)+`"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, "expected status 422, body: %s", rec.Body.String())
+
+	rec = doJSON(t, http.MethodPatch, location, `{"orderNumber":11}`)
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+	err = json.Unmarshal(rec.Body.Bytes(), &category)
+	require.NoError(t, err, "failed to unmarshal response")
+	assert.Equal(t, 11, category.OrderNumber)
+	assert.Equal(t, "REST Category", category.Title)
+
+	rec = doJSON(t, http.MethodDelete, location, "")
+	require.Equal(t, http.StatusNoContent, rec.Code, "expected status 204")
+
+	rec = doJSON(t, http.MethodGet, location, "")
+	require.Equal(t, http.StatusNotFound, rec.Code, "expected status 404 for deleted category")
+}
+
+func TestNewsHandler_TagCRUD_Integration(t *testing.T) {
+	rec := doJSON(t, http.MethodPost, "/api/v1/tags", `{"title":"REST Tag","statusId":1}`)
+	require.Equal(t, http.StatusCreated, rec.Code, "expected status 201, body: %s", rec.Body.String())
+
+	var tag Tag
+	err := json.Unmarshal(rec.Body.Bytes(), &tag)
+	require.NoError(t, err, "failed to unmarshal response")
+	location := fmt.Sprintf("/api/v1/tags/%d", tag.TagID)
+	assert.Equal(t, location, rec.Header().Get("Location"))
+
+	rec = doJSON(t, http.MethodPut, location, `{"title":"REST Tag Updated","statusId":5}`)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, "expected status 422, body: %s", rec.Body.String())
+
+	rec = doJSON(t, http.MethodPut, location, `{"title":"REST Tag Updated","statusId":1}`)
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+	rec = doJSON(t, http.MethodDelete, location, "")
+	require.Equal(t, http.StatusNoContent, rec.Code, "expected status 204")
+
+	rec = doJSON(t, http.MethodPut, "/api/v1/tags/99999", `{"title":"Unknown","statusId":1}`)
+	require.Equal(t, http.StatusNotFound, rec.Code, "expected status 404")
+}
@@ -0,0 +1,255 @@
+package rest
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
+
+const (
+	feedSize       = 50
+	feedExcerptLen = 300
+
+	jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+	atomNamespace   = "http://www.w3.org/2005/Atom"
+	dcNamespace     = "http://purl.org/dc/elements/1.1/"
+)
+
+// Feed describes feed channel: title, description and base URL for links.
+type Feed struct {
+	Title       string
+	Description string
+	BaseURL     string
+	// Path is a feed path, e.g. /feed/rss.xml.
+	Path string
+}
+
+func (f Feed) newsURL(newsID int) string {
+	return newsURL(f.BaseURL, newsID)
+}
+
+// newsURL returns absolute news URL.
+func newsURL(baseURL string, newsID int) string {
+	return baseURL + "/api/v1/news/" + strconv.Itoa(newsID)
+}
+
+type RSS struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel RSSChannel `xml:"channel"`
+}
+
+type RSSChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []RSSItem `xml:"item"`
+}
+
+type RSSItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        string   `xml:"guid"`
+	Creator     string   `xml:"dc:creator"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description"`
+}
+
+type Atom struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []AtomLink  `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type AtomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       AtomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     AtomAuthor     `xml:"author"`
+	Categories []AtomCategory `xml:"category"`
+	Summary    string         `xml:"summary"`
+}
+
+type AtomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type AtomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// JSONFeed is a JSON Feed 1.1 document.
+type JSONFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Items       []JSONFeedItem `json:"items"`
+}
+
+type JSONFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url,omitempty"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary,omitempty"`
+	DatePublished time.Time        `json:"date_published"`
+	DateModified  *time.Time       `json:"date_modified,omitempty"`
+	Authors       []JSONFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+	// Category is a JSON Feed extension with news category.
+	Category *Category `json:"_category,omitempty"`
+}
+
+type JSONFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+// NewRSS returns RSS 2.0 document for news. Category and tag titles are item categories.
+func NewRSS(f Feed, list newsportal.NewsList) RSS {
+	channel := RSSChannel{
+		Title:       f.Title,
+		Link:        f.BaseURL + "/",
+		Description: f.Description,
+		Items:       make([]RSSItem, 0, len(list)),
+	}
+
+	if updated := list.LastModified(); !updated.IsZero() {
+		channel.LastBuildDate = updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, n := range list {
+		link := f.newsURL(n.ID)
+		channel.Items = append(channel.Items, RSSItem{
+			Title:       n.Title,
+			Link:        link,
+			GUID:        link,
+			Creator:     n.Author,
+			PubDate:     n.PublishedAt.UTC().Format(time.RFC1123Z),
+			Categories:  feedCategories(n),
+			Description: n.Excerpt(feedExcerptLen),
+		})
+	}
+
+	return RSS{Version: "2.0", DC: dcNamespace, Channel: channel}
+}
+
+// NewAtom returns Atom 1.0 document for news. Category and tag titles are entry categories.
+func NewAtom(f Feed, list newsportal.NewsList) Atom {
+	feed := Atom{
+		Xmlns: atomNamespace,
+		ID:    f.BaseURL + f.Path,
+		Title: f.Title,
+		Links: []AtomLink{
+			{Href: f.BaseURL + "/"},
+			{Href: f.BaseURL + f.Path, Rel: "self", Type: "application/atom+xml"},
+		},
+		Entries: make([]AtomEntry, 0, len(list)),
+	}
+
+	// updated is required by Atom, use current time for empty feed
+	updated := list.LastModified()
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	feed.Updated = updated.UTC().Format(time.RFC3339)
+
+	for _, n := range list {
+		entryUpdated := n.PublishedAt
+		if n.UpdatedAt != nil && n.UpdatedAt.After(entryUpdated) {
+			entryUpdated = *n.UpdatedAt
+		}
+
+		categories := feedCategories(n)
+		entry := AtomEntry{
+			ID:         f.newsURL(n.ID),
+			Title:      n.Title,
+			Link:       AtomLink{Href: f.newsURL(n.ID)},
+			Published:  n.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:    entryUpdated.UTC().Format(time.RFC3339),
+			Author:     AtomAuthor{Name: n.Author},
+			Categories: make([]AtomCategory, len(categories)),
+			Summary:    n.Excerpt(feedExcerptLen),
+		}
+		for i, c := range categories {
+			entry.Categories[i] = AtomCategory{Term: c}
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
+
+// NewJSONFeed returns JSON Feed 1.1 document for news. Tag titles are item tags, category is _category extension.
+func NewJSONFeed(f Feed, list newsportal.NewsList) JSONFeed {
+	feed := JSONFeed{
+		Version:     jsonFeedVersion,
+		Title:       f.Title,
+		HomePageURL: f.BaseURL + "/",
+		FeedURL:     f.BaseURL + f.Path,
+		Description: f.Description,
+		Items:       make([]JSONFeedItem, 0, len(list)),
+	}
+
+	for _, n := range list {
+		item := JSONFeedItem{
+			ID:            strconv.Itoa(n.ID),
+			URL:           f.newsURL(n.ID),
+			Title:         n.Title,
+			Summary:       n.Excerpt(feedExcerptLen),
+			DatePublished: n.PublishedAt,
+			DateModified:  n.UpdatedAt,
+			Tags:          make([]string, len(n.Tags)),
+		}
+
+		if n.Content != nil {
+			item.ContentText = *n.Content
+		}
+		if n.Author != "" {
+			item.Authors = []JSONFeedAuthor{{Name: n.Author}}
+		}
+		if n.Category.ID != 0 {
+			category := NewCategory(n.Category)
+			item.Category = &category
+		}
+		for i, t := range n.Tags {
+			item.Tags[i] = t.Title
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed
+}
+
+// feedCategories returns category title followed by tag titles.
+func feedCategories(n newsportal.News) []string {
+	r := make([]string, 0, len(n.Tags)+1)
+	if n.Category.Title != "" {
+		r = append(r, n.Category.Title)
+	}
+	for _, t := range n.Tags {
+		r = append(r, t.Title)
+	}
+
+	return r
+}
@@ -0,0 +1,239 @@
+package rest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	feedFormatRSS  = "rss"
+	feedFormatAtom = "atom"
+	feedFormatJSON = "json"
+
+	mimeRSS      = "application/rss+xml; charset=UTF-8"
+	mimeAtom     = "application/atom+xml; charset=UTF-8"
+	mimeJSONFeed = "application/feed+json; charset=UTF-8"
+
+	feedTitle       = "News Portal"
+	feedDescription = "Latest news"
+)
+
+type FeedRequest struct {
+	Format string `query:"format"`
+}
+
+// RSSFeed handles GET /feed/rss.xml
+// @Summary RSS feed
+// @Description Returns RSS 2.0 feed of latest visible news. Supports If-Modified-Since
+// @Tags feeds
+// @Produce xml
+// @Success 200 {string} string "RSS 2.0 document"
+// @Success 304 "Not modified"
+// @Failure 500 {object} map[string]string
+// @Router /feed/rss.xml [get]
+func (h *NewsHandler) RSSFeed(c echo.Context) error {
+	return h.writeFeed(c, feedFormatRSS, newFeed(c, feedTitle, feedDescription), nil, nil)
+}
+
+// AtomFeed handles GET /feed/atom.xml
+// @Summary Atom feed
+// @Description Returns Atom 1.0 feed of latest visible news. Supports If-Modified-Since
+// @Tags feeds
+// @Produce xml
+// @Success 200 {string} string "Atom 1.0 document"
+// @Success 304 "Not modified"
+// @Failure 500 {object} map[string]string
+// @Router /feed/atom.xml [get]
+func (h *NewsHandler) AtomFeed(c echo.Context) error {
+	return h.writeFeed(c, feedFormatAtom, newFeed(c, feedTitle, feedDescription), nil, nil)
+}
+
+// JSONFeed handles GET /feed/feed.json
+// @Summary JSON feed
+// @Description Returns JSON Feed 1.1 of latest visible news. Category is in _category extension. Supports If-Modified-Since
+// @Tags feeds
+// @Produce json
+// @Success 200 {object} rest.JSONFeed
+// @Success 304 "Not modified"
+// @Failure 500 {object} map[string]string
+// @Router /feed/feed.json [get]
+func (h *NewsHandler) JSONFeed(c echo.Context) error {
+	return h.writeFeed(c, feedFormatJSON, newFeed(c, feedTitle, feedDescription), nil, nil)
+}
+
+// CategoryFeed handles GET /feed/category/:id.xml and GET /feed/category/:id.json
+// @Summary Category feed
+// @Description Returns RSS 2.0 (default) or Atom feed of latest visible news in category, or JSON Feed 1.1 for .json extension. Supports If-Modified-Since
+// @Tags feeds
+// @Produce xml,json
+// @Param id path int true "Category ID"
+// @Param format query string false "Feed format: rss or atom"
+// @Success 200 {string} string "RSS 2.0, Atom or JSON Feed document"
+// @Success 304 "Not modified"
+// @Failure 400,404,500 {object} map[string]string
+// @Router /feed/category/{id}.xml [get]
+// @Router /feed/category/{id}.json [get]
+func (h *NewsHandler) CategoryFeed(c echo.Context) error {
+	id, format, err := feedParams(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	category, err := h.uc.CategoryByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	} else if category == nil {
+		return h.handleError(c, nil, http.StatusNotFound, "category not found")
+	}
+
+	f := newFeed(c, feedTitle+": "+category.Title, "Latest news in category "+category.Title)
+	return h.writeFeed(c, format, f, nil, &id)
+}
+
+// TagFeed handles GET /feed/tag/:id.xml and GET /feed/tag/:id.json
+// @Summary Tag feed
+// @Description Returns RSS 2.0 (default) or Atom feed of latest visible news with tag, or JSON Feed 1.1 for .json extension. Supports If-Modified-Since
+// @Tags feeds
+// @Produce xml,json
+// @Param id path int true "Tag ID"
+// @Param format query string false "Feed format: rss or atom"
+// @Success 200 {string} string "RSS 2.0, Atom or JSON Feed document"
+// @Success 304 "Not modified"
+// @Failure 400,404,500 {object} map[string]string
+// @Router /feed/tag/{id}.xml [get]
+// @Router /feed/tag/{id}.json [get]
+func (h *NewsHandler) TagFeed(c echo.Context) error {
+	id, format, err := feedParams(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	tag, err := h.uc.TagByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	} else if tag == nil {
+		return h.handleError(c, nil, http.StatusNotFound, "tag not found")
+	}
+
+	f := newFeed(c, feedTitle+": "+tag.Title, "Latest news with tag "+tag.Title)
+	return h.writeFeed(c, format, f, &id, nil)
+}
+
+// newFeed returns feed with base URL taken from request.
+func newFeed(c echo.Context, title, description string) Feed {
+	return Feed{
+		Title:       title,
+		Description: description,
+		BaseURL:     baseURL(c),
+		Path:        c.Request().URL.Path,
+	}
+}
+
+// baseURL returns scheme and host of request.
+func baseURL(c echo.Context) string {
+	return c.Scheme() + "://" + c.Request().Host
+}
+
+// writeFeed loads latest news and writes feed in format. Responds 304 if If-None-Match matches ETag of news,
+// or if news are not modified since If-Modified-Since when If-None-Match is absent.
+func (h *NewsHandler) writeFeed(c echo.Context, format string, f Feed, tagID, categoryID *int) error {
+	pageSize := feedSize
+	list, err := h.uc.NewsByFilter(c.Request().Context(), tagID, categoryID, nil, &pageSize)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	// ETag includes ids of news, so feed is modified when news is removed without changing max date
+	setNewsValidators(c, list)
+	if notModifiedSince(c.Request(), c.Response().Header()) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	if format == feedFormatJSON {
+		body, err := json.Marshal(NewJSONFeed(f, list))
+		if err != nil {
+			return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+		}
+
+		return c.Blob(http.StatusOK, mimeJSONFeed, body)
+	}
+
+	var (
+		doc  any
+		mime string
+	)
+	switch format {
+	case feedFormatAtom:
+		doc, mime = NewAtom(f, list), mimeAtom
+	default:
+		doc, mime = NewRSS(f, list), mimeRSS
+	}
+
+	body, err := xml.Marshal(doc)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	return c.Blob(http.StatusOK, mime, append([]byte(xml.Header), body...))
+}
+
+// notModified reports whether If-Modified-Since is not before lastModified. HTTP dates have second precision.
+func notModified(r *http.Request, lastModified time.Time) bool {
+	since, err := http.ParseTime(r.Header.Get(echo.HeaderIfModifiedSince))
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// feedParams returns id from :id.xml or :id.json path param and feed format.
+// Format of .xml feed is taken from query, .json feed is JSON Feed.
+func feedParams(c echo.Context) (int, string, error) {
+	var req FeedRequest
+	if err := c.Bind(&req); err != nil {
+		return 0, "", err
+	}
+
+	switch req.Format {
+	case "", feedFormatRSS, feedFormatAtom:
+	default:
+		return 0, "", errors.New("unknown format")
+	}
+
+	id, ext, err := pathIDExt(c, ".xml", ".json")
+	if err != nil {
+		return 0, "", err
+	} else if ext == ".json" {
+		req.Format = feedFormatJSON
+	}
+
+	return id, req.Format, nil
+}
+
+// pathIDExt returns positive id and extension from :id path param with one of extensions, e.g. 1.xml.
+func pathIDExt(c echo.Context, exts ...string) (int, string, error) {
+	for _, ext := range exts {
+		param, ok := strings.CutSuffix(c.Param("id"), ext)
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.Atoi(param)
+		if err != nil || id <= 0 {
+			return 0, "", errors.New("invalid id")
+		}
+
+		return id, ext, nil
+	}
+
+	return 0, "", fmt.Errorf("extension must be one of %s", strings.Join(exts, ", "))
+}
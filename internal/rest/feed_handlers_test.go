@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doGet(t *testing.T, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := testHandler.RegisterRoutes()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestNewsHandler_RSSFeed_Integration(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		rec := doGet(t, "/feed/rss.xml", nil)
+
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/rss+xml")
+		assert.NotEmpty(t, rec.Header().Get("Last-Modified"), "expected Last-Modified header")
+
+		var rss RSS
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &rss), "failed to unmarshal feed")
+		assert.Equal(t, "2.0", rss.Version)
+		require.NotEmpty(t, rss.Channel.Items, "expected feed items")
+
+		item := rss.Channel.Items[0]
+		assert.NotEmpty(t, item.Title)
+		assert.NotEmpty(t, item.Link)
+		assert.NotEmpty(t, item.PubDate)
+		assert.NotEmpty(t, item.Description, "expected content excerpt")
+		assert.NotEmpty(t, item.Categories, "expected category and tags")
+	})
+
+	t.Run("NotModified", func(t *testing.T) {
+		rec := doGet(t, "/feed/rss.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		lastModified := rec.Header().Get("Last-Modified")
+		rec = doGet(t, "/feed/rss.xml", http.Header{"If-Modified-Since": {lastModified}})
+		assert.Equal(t, http.StatusNotModified, rec.Code, "expected status 304")
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("IfNoneMatch", func(t *testing.T) {
+		rec := doGet(t, "/feed/rss.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		etag := rec.Header().Get("ETag")
+		require.NotEmpty(t, etag, "expected ETag header")
+
+		rec = doGet(t, "/feed/rss.xml", http.Header{"If-None-Match": {etag}})
+		assert.Equal(t, http.StatusNotModified, rec.Code, "expected status 304")
+
+		// If-Modified-Since is ignored when If-None-Match is present
+		lastModified := rec.Header().Get("Last-Modified")
+		rec = doGet(t, "/feed/rss.xml", http.Header{"If-None-Match": {`W/"other"`}, "If-Modified-Since": {lastModified}})
+		assert.Equal(t, http.StatusOK, rec.Code, "expected status 200")
+	})
+
+	t.Run("ModifiedSinceOldDate", func(t *testing.T) {
+		since := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+		rec := doGet(t, "/feed/rss.xml", http.Header{"If-Modified-Since": {since}})
+		assert.Equal(t, http.StatusOK, rec.Code, "expected status 200")
+	})
+}
+
+func TestNewsHandler_AtomFeed_Integration(t *testing.T) {
+	rec := doGet(t, "/feed/atom.xml", nil)
+
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/atom+xml")
+
+	var atom Atom
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &atom), "failed to unmarshal feed")
+	assert.NotEmpty(t, atom.Updated)
+	require.NotEmpty(t, atom.Entries, "expected feed entries")
+	assert.NotEmpty(t, atom.Entries[0].Author.Name)
+	assert.NotEmpty(t, atom.Entries[0].Summary)
+}
+
+func TestNewsHandler_JSONFeed_Integration(t *testing.T) {
+	rec := doGet(t, "/feed/feed.json", nil)
+
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/feed+json")
+
+	var feed JSONFeed
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &feed), "failed to unmarshal feed")
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", feed.Version)
+	require.NotEmpty(t, feed.Items, "expected feed items")
+
+	item := feed.Items[0]
+	assert.NotEmpty(t, item.ID)
+	assert.NotEmpty(t, item.ContentText)
+	assert.NotEmpty(t, item.Tags, "expected tag titles")
+	require.NotNil(t, item.Category, "expected _category extension")
+	assert.NotEmpty(t, item.Category.Title)
+}
+
+func TestNewsHandler_CategoryFeed_Integration(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		rec := doGet(t, "/feed/category/1.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var rss RSS
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &rss), "failed to unmarshal feed")
+		require.NotEmpty(t, rss.Channel.Items, "expected feed items")
+		for _, item := range rss.Channel.Items {
+			assert.Equal(t, "Technology", item.Categories[0], "expected category of feed")
+		}
+	})
+
+	t.Run("AtomFormat", func(t *testing.T) {
+		rec := doGet(t, "/feed/category/1.xml?format=atom", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/atom+xml")
+	})
+
+	t.Run("JSONFeed", func(t *testing.T) {
+		rec := doGet(t, "/feed/category/1.json", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var feed JSONFeed
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &feed), "failed to unmarshal feed")
+		require.NotEmpty(t, feed.Items, "expected feed items")
+		for _, item := range feed.Items {
+			require.NotNil(t, item.Category)
+			assert.Equal(t, 1, item.Category.CategoryID, "expected category of feed")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		rec := doGet(t, "/feed/category/99999.xml", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code, "expected status 404")
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		rec := doGet(t, "/feed/category/abc.xml", nil)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, "expected status 400")
+	})
+}
+
+func TestNewsHandler_TagFeed_Integration(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		rec := doGet(t, "/feed/tag/1.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var rss RSS
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &rss), "failed to unmarshal feed")
+		require.NotEmpty(t, rss.Channel.Items, "expected feed items")
+		for _, item := range rss.Channel.Items {
+			assert.Contains(t, item.Categories, "Important", "expected tag of feed")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		rec := doGet(t, "/feed/tag/99999.xml", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code, "expected status 404")
+	})
+}
@@ -0,0 +1,226 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/labstack/echo/v4"
+)
+
+// Validator headers are not defined in echo.
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// varyCredentials lists request headers with credentials, responses depend on them through principal.
+var varyCredentials = echo.HeaderAuthorization + ", " + newsportal.HeaderAPIKey
+
+// HTTPCacheConfig configures ETag, conditional requests and Cache-Control of read endpoints.
+type HTTPCacheConfig struct {
+	// Enabled turns on cache validators and Cache-Control.
+	Enabled bool
+	// MaxAge is a default Cache-Control max-age. Zero max-age means no-cache, responses are always revalidated.
+	MaxAge time.Duration
+	// Routes overrides MaxAge by route path, e.g. "/api/v1/news/:id" or "/rpc".
+	Routes map[string]time.Duration
+}
+
+// maxAge returns max-age of route.
+func (cfg HTTPCacheConfig) maxAge(route string) time.Duration {
+	if d, ok := cfg.Routes[route]; ok {
+		return d
+	}
+
+	return cfg.MaxAge
+}
+
+// cacheControl returns Cache-Control header value of route. Responses to requests with credentials are private.
+func (cfg HTTPCacheConfig) cacheControl(r *http.Request, route string) string {
+	maxAge := cfg.maxAge(route)
+	if maxAge <= 0 {
+		return "no-cache"
+	}
+
+	scope := "public"
+	if r.Header.Get(echo.HeaderAuthorization) != "" || r.Header.Get(newsportal.HeaderAPIKey) != "" {
+		scope = "private"
+	}
+
+	return scope + ", max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+}
+
+// IsGET reports whether request is GET.
+func IsGET(r *http.Request) bool {
+	return r.Method == http.MethodGet
+}
+
+// HTTPCache returns middleware that adds validators to 200 responses of cacheable requests and
+// answers If-None-Match and If-Modified-Since of GET and HEAD requests with 304. Cacheable POST requests,
+// e.g. read-only JSON-RPC calls, get validators but never 304 (RFC 9110). ETag set by handler is kept,
+// otherwise weak ETag is computed from response body. Responses flushed by handler are passed through.
+// Responses vary by credential headers, so shared caches don't serve them to other principals.
+func HTTPCache(cfg HTTPCacheConfig, cacheable func(r *http.Request) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !cfg.Enabled || !cacheable(c.Request()) {
+				return next(c)
+			}
+
+			res := c.Response()
+			res.Header().Add(echo.HeaderVary, varyCredentials)
+			w := &bufferedWriter{ResponseWriter: res.Writer}
+			res.Writer = w
+			defer func() { res.Writer = w.ResponseWriter }()
+
+			if err := next(c); err != nil {
+				return err
+			}
+
+			if w.flushed {
+				return nil
+			}
+
+			if w.status != http.StatusOK {
+				return w.writeTo(w.ResponseWriter)
+			}
+
+			h := w.Header()
+			if h.Get(headerETag) == "" {
+				h.Set(headerETag, bodyETag(w.body.Bytes()))
+			}
+			h.Set(echo.HeaderCacheControl, cfg.cacheControl(c.Request(), c.Path()))
+
+			if isSafe(c.Request()) && notModifiedSince(c.Request(), h) {
+				h.Del(echo.HeaderContentType)
+				h.Del(echo.HeaderContentLength)
+				res.Status = http.StatusNotModified
+				w.ResponseWriter.WriteHeader(http.StatusNotModified)
+				return nil
+			}
+
+			return w.writeTo(w.ResponseWriter)
+		}
+	}
+}
+
+// isSafe reports whether conditional request may be answered with 304.
+func isSafe(r *http.Request) bool {
+	return r.Method == http.MethodGet || r.Method == http.MethodHead
+}
+
+// notModifiedSince reports whether request validators match response headers.
+// If-Modified-Since is ignored when If-None-Match is present.
+func notModifiedSince(r *http.Request, h http.Header) bool {
+	if inm := r.Header.Get(headerIfNoneMatch); inm != "" {
+		return etagMatch(inm, h.Get(headerETag))
+	}
+
+	lastModified, err := http.ParseTime(h.Get(echo.HeaderLastModified))
+	if err != nil {
+		return false
+	}
+
+	return notModified(r, lastModified)
+}
+
+// etagMatch reports whether If-None-Match list contains etag using weak comparison.
+func etagMatch(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bodyETag returns weak ETag of response body.
+func bodyETag(body []byte) string {
+	h := fnv.New64a()
+	h.Write(body)
+
+	return fmt.Sprintf(`W/"%x"`, h.Sum64())
+}
+
+// setNewsValidators sets weak ETag and Last-Modified of news list without hashing response body.
+// ETag is built from request URI with filters, max(updatedAt, publishedAt) and ids of news, so it
+// changes when news are edited, added or removed.
+func setNewsValidators(c echo.Context, list newsportal.NewsList, extra ...int) {
+	lastModified := list.LastModified()
+
+	h := fnv.New64a()
+	h.Write([]byte(c.Request().URL.RequestURI()))
+	fmt.Fprintf(h, "|%d|%v|%v", lastModified.UnixNano(), list.IDs(), extra)
+
+	header := c.Response().Header()
+	header.Set(headerETag, fmt.Sprintf(`W/"%x"`, h.Sum64()))
+	if !lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// bufferedWriter buffers response to compute ETag. After Flush response is written through.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status  int
+	body    bytes.Buffer
+	flushed bool
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if w.flushed {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+
+	w.status = code
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.flushed {
+		return w.ResponseWriter.Write(b)
+	}
+
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return w.body.Write(b)
+}
+
+// Flush writes buffered response and switches to write through.
+func (w *bufferedWriter) Flush() {
+	if !w.flushed {
+		w.flushed = true
+		_ = w.writeTo(w.ResponseWriter)
+	}
+
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *bufferedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// writeTo writes buffered status and body.
+func (w *bufferedWriter) writeTo(rw http.ResponseWriter) error {
+	if w.status != 0 {
+		rw.WriteHeader(w.status)
+	}
+
+	_, err := rw.Write(w.body.Bytes())
+	return err
+}
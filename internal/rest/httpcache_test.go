@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPCache(t *testing.T) {
+	cfg := HTTPCacheConfig{
+		Enabled: true,
+		MaxAge:  time.Minute,
+		Routes:  map[string]time.Duration{"/no-cache": 0},
+	}
+
+	e := echo.New()
+	cache := HTTPCache(cfg, IsGET)
+	e.GET("/body", func(c echo.Context) error {
+		return c.String(http.StatusOK, "body")
+	}, cache)
+	e.GET("/no-cache", func(c echo.Context) error {
+		return c.String(http.StatusOK, "body")
+	}, cache)
+	e.GET("/error", func(c echo.Context) error {
+		return c.String(http.StatusNotFound, "not found")
+	}, cache)
+
+	do := func(target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("SetsETagAndCacheControl", func(t *testing.T) {
+		rec := do("/body", nil)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "body", rec.Body.String())
+		assert.Equal(t, bodyETag([]byte("body")), rec.Header().Get(headerETag))
+		assert.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+		assert.Equal(t, "Authorization, X-API-Key", rec.Header().Get(echo.HeaderVary))
+	})
+
+	t.Run("PrivateWithCredentials", func(t *testing.T) {
+		rec := do("/body", http.Header{echo.HeaderAuthorization: {"Bearer token"}})
+
+		assert.Equal(t, "private, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+		assert.Equal(t, "Authorization, X-API-Key", rec.Header().Get(echo.HeaderVary))
+	})
+
+	t.Run("RouteCacheControl", func(t *testing.T) {
+		rec := do("/no-cache", nil)
+
+		assert.Equal(t, "no-cache", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("IfNoneMatch", func(t *testing.T) {
+		etag := do("/body", nil).Header().Get(headerETag)
+
+		rec := do("/body", http.Header{headerIfNoneMatch: {`"other", ` + etag}})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.String())
+		assert.Equal(t, etag, rec.Header().Get(headerETag))
+
+		rec = do("/body", http.Header{headerIfNoneMatch: {`W/"other"`}})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("NoNotModifiedForPOST", func(t *testing.T) {
+		e := echo.New()
+		e.POST("/rpc", func(c echo.Context) error {
+			return c.String(http.StatusOK, "body")
+		}, HTTPCache(cfg, func(*http.Request) bool { return true }))
+
+		req := httptest.NewRequest(http.MethodPost, "/rpc", nil)
+		req.Header.Set(headerIfNoneMatch, bodyETag([]byte("body")))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "body", rec.Body.String())
+		assert.Equal(t, bodyETag([]byte("body")), rec.Header().Get(headerETag))
+	})
+
+	t.Run("SkipsErrors", func(t *testing.T) {
+		rec := do("/error", nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "not found", rec.Body.String())
+		assert.Empty(t, rec.Header().Get(headerETag))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		e := echo.New()
+		e.GET("/body", func(c echo.Context) error {
+			return c.String(http.StatusOK, "body")
+		}, HTTPCache(HTTPCacheConfig{}, IsGET))
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/body", nil))
+
+		assert.Empty(t, rec.Header().Get(headerETag))
+		assert.Empty(t, rec.Header().Get(echo.HeaderCacheControl))
+	})
+}
+
+func TestNewsHandler_HTTPCache_Integration(t *testing.T) {
+	h := NewNewsHandler(testHandler.uc, testHandler.log).WithHTTPCache(HTTPCacheConfig{
+		Enabled: true,
+		MaxAge:  time.Minute,
+	})
+	e := h.RegisterRoutes()
+
+	do := func(target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("NewsListIfNoneMatch", func(t *testing.T) {
+		rec := do("/api/v1/news?pageSize=5", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		etag := rec.Header().Get(headerETag)
+		require.NotEmpty(t, etag, "expected ETag header")
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderLastModified), "expected Last-Modified header")
+
+		rec = do("/api/v1/news?pageSize=5", http.Header{headerIfNoneMatch: {etag}})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+
+		other := do("/api/v1/news?pageSize=3", nil).Header().Get(headerETag)
+		assert.NotEqual(t, etag, other, "expected ETag to depend on filter")
+	})
+
+	t.Run("NewsByIDIfModifiedSince", func(t *testing.T) {
+		rec := do("/api/v1/news/1", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = do("/api/v1/news/1", http.Header{echo.HeaderIfModifiedSince: {rec.Header().Get(echo.HeaderLastModified)}})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+	})
+
+	t.Run("CategoriesBodyETag", func(t *testing.T) {
+		rec := do("/api/v1/categories", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, bodyETag(rec.Body.Bytes()), rec.Header().Get(headerETag))
+	})
+}
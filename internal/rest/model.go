@@ -3,8 +3,10 @@ package rest
 import "time"
 
 type Category struct {
-	CategoryID int    `json:"categoryId"`
-	Title      string `json:"title"`
+	CategoryID  int    `json:"categoryId"`
+	Title       string `json:"title"`
+	OrderNumber int    `json:"orderNumber"`
+	StatusID    int    `json:"statusId"`
 }
 
 type Tag struct {
@@ -20,6 +22,7 @@ type News struct {
 	Content     string    `json:"content"`
 	Author      string    `json:"author"`
 	PublishedAt time.Time `json:"publishedAt"`
+	State       string    `json:"state"`
 	Category    Category  `json:"category"`
 	Tags        []Tag     `json:"tags"`
 }
@@ -32,4 +35,103 @@ type NewsSummary struct {
 	PublishedAt time.Time `json:"publishedAt"`
 	Category    Category  `json:"category"`
 	Tags        []Tag     `json:"tags"`
+	Snippet     *string   `json:"snippet,omitempty"`
+}
+
+// NewsPage is a news page with total count of news matching filter.
+type NewsPage struct {
+	Items    []NewsSummary `json:"items"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"pageSize"`
+	HasNext  bool          `json:"hasNext"`
+}
+
+// NewsCursorPage is a news page of cursor pagination.
+type NewsCursorPage struct {
+	Items []NewsSummary `json:"items"`
+	// NextCursor is a cursor of the next page, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// NewsInput is a request body for POST and PUT /api/v1/news.
+type NewsInput struct {
+	CategoryID  int       `json:"categoryId"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	Author      string    `json:"author"`
+	PublishedAt time.Time `json:"publishedAt"`
+	TagIDs      []int     `json:"tagIds"`
+	StatusID    int       `json:"statusId"`
+}
+
+// NewsPatch is a request body for PATCH /api/v1/news/:id. Only present fields are updated.
+type NewsPatch struct {
+	CategoryID  *int       `json:"categoryId"`
+	Title       *string    `json:"title"`
+	Content     *string    `json:"content"`
+	Author      *string    `json:"author"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	TagIDs      *[]int     `json:"tagIds"`
+	StatusID    *int       `json:"statusId"`
+}
+
+// CategoryInput is a request body for POST and PUT /api/v1/categories.
+type CategoryInput struct {
+	Title       string `json:"title"`
+	OrderNumber int    `json:"orderNumber"`
+	StatusID    int    `json:"statusId"`
+}
+
+// CategoryPatch is a request body for PATCH /api/v1/categories/:id. Only present fields are updated.
+type CategoryPatch struct {
+	Title       *string `json:"title"`
+	OrderNumber *int    `json:"orderNumber"`
+	StatusID    *int    `json:"statusId"`
+}
+
+// TagInput is a request body for POST and PUT /api/v1/tags.
+type TagInput struct {
+	Title    string `json:"title"`
+	StatusID int    `json:"statusId"`
+}
+
+// TagPatch is a request body for PATCH /api/v1/tags/:id. Only present fields are updated.
+type TagPatch struct {
+	Title    *string `json:"title"`
+	StatusID *int    `json:"statusId"`
+}
+
+// NewsRevision is a snapshot of news title, content, category and tags.
+type NewsRevision struct {
+	NewsRevisionID int       `json:"newsRevisionId"`
+	NewsID         int       `json:"newsId"`
+	CategoryID     int       `json:"categoryId"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	TagIDs         []int     `json:"tagIds"`
+	Actor          string    `json:"actor"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// NewsRevisionDiff is a difference between two revisions of news.
+type NewsRevisionDiff struct {
+	From NewsRevision `json:"from"`
+	To   NewsRevision `json:"to"`
+	// Fields contains changed title, categoryId and tagIds.
+	Fields map[string]Change `json:"fields"`
+	// Content is a line diff of content.
+	Content []DiffLine `json:"content"`
+}
+
+// Change is an old and new value of field.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// DiffLine is a line of content diff. Op is "=" for unchanged, "+" for inserted and "-" for deleted line.
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
 }
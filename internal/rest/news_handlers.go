@@ -1,19 +1,33 @@
 package rest
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/daniilsolovey/news-portal/internal/newsportal"
 	"github.com/labstack/echo/v4"
 )
 
 type NewsRequest struct {
-	TagID      *int `query:"tagId"`
-	CategoryID *int `query:"categoryId"`
-	Page       *int `query:"page"`
-	PageSize   *int `query:"pageSize"`
+	TagID      *int    `query:"tagId"`
+	CategoryID *int    `query:"categoryId"`
+	Page       *int    `query:"page"`
+	PageSize   *int    `query:"pageSize"`
+	Cursor     *string `query:"cursor"`
+}
+
+// headerNextCursor contains cursor of the next page for cursor pagination.
+const headerNextCursor = "X-Next-Cursor"
+
+type NewsSearchRequest struct {
+	Query      string `query:"q"`
+	TagID      *int   `query:"tagId"`
+	CategoryID *int   `query:"categoryId"`
+	Page       *int   `query:"page"`
+	PageSize   *int   `query:"pageSize"`
 }
 
 type NewsCountRequest struct {
@@ -22,8 +36,11 @@ type NewsCountRequest struct {
 }
 
 type NewsHandler struct {
-	uc  *newsportal.Manager
-	log *slog.Logger
+	uc        *newsportal.Manager
+	log       *slog.Logger
+	httpCache HTTPCacheConfig
+	auth      *newsportal.AuthManager
+	stream    *newsportal.NewsStream
 }
 
 func NewNewsHandler(uc *newsportal.Manager, log *slog.Logger) *NewsHandler {
@@ -33,29 +50,50 @@ func NewNewsHandler(uc *newsportal.Manager, log *slog.Logger) *NewsHandler {
 	}
 }
 
+// WithHTTPCache enables ETag, conditional GET and Cache-Control on read routes.
+func (h *NewsHandler) WithHTTPCache(cfg HTTPCacheConfig) *NewsHandler {
+	h.httpCache = cfg
+	return h
+}
+
+// WithAuth enables authentication. Write routes require editor role, read routes require AuthConfig.ReadRole.
+func (h *NewsHandler) WithAuth(auth *newsportal.AuthManager) *NewsHandler {
+	h.auth = auth
+	return h
+}
+
 func (h *NewsHandler) handleError(c echo.Context, err error, statusCode int, message string) error {
 	h.log.Error("handleError", "error", err, "statusCode", statusCode, "message", message)
 	return c.JSON(statusCode, map[string]string{"error": message})
 }
 
-// News handles GET /api/v1/all_news
+// News handles GET /api/v1/news
 // @Summary Get all news
-// @Description Retrieves news with optional filtering by tagId and categoryId, with pagination. Returns NewsSummary (without content) sorted by publishedAt DESC
+// @Description Retrieves news with optional filtering by tagId and categoryId, with pagination. Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC.
+// @Description With cursor parameter (empty for the first page) page is ignored and response is an array too, cursor of the next page is returned only in X-Next-Cursor and Link headers.
+// @Description Use GET /api/v1/news/cursor to get it in response body
 // @Tags news
 // @Produce json
 // @Param tagId query int false "Filter by tag ID"
 // @Param categoryId query int false "Filter by category ID"
 // @Param page query int false "Page number (default: 1)"
 // @Param pageSize query int false "Page size (default: 10)"
+// @Param cursor query string false "Opaque cursor from X-Next-Cursor header"
 // @Success 200 {array} rest.NewsSummary
+// @Header 200 {string} X-Next-Cursor "Cursor of the next page, absent on the last page"
+// @Header 200 {string} Link "URL of the next page with rel=next, absent on the last page"
 // @Failure 400,500 {object} map[string]string
-// @Router /api/v1/all_news [get]
+// @Router /api/v1/news [get]
 func (h *NewsHandler) News(c echo.Context) error {
 	var req NewsRequest
 	if err := c.Bind(&req); err != nil {
 		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
 	}
 
+	if req.Cursor != nil {
+		return h.newsByCursor(c, req)
+	}
+
 	newsportalSummaries, err := h.uc.NewsByFilter(
 		c.Request().Context(), req.TagID, req.CategoryID, req.Page, req.PageSize,
 	)
@@ -64,11 +102,147 @@ func (h *NewsHandler) News(c echo.Context) error {
 	}
 
 	summaries := NewNewsSummaries(newsportalSummaries)
+	setNewsValidators(c, newsportalSummaries)
 
 	return c.JSON(http.StatusOK, summaries)
 }
 
-// NewsCount handles GET /api/v1/count
+// newsByCursor writes news page after cursor and sets next page cursor headers.
+func (h *NewsHandler) newsByCursor(c echo.Context, req NewsRequest) error {
+	list, _, err := h.cursorPage(c, req)
+	if err != nil {
+		return h.handleCursorError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, NewNewsSummaries(list))
+}
+
+// NewsByCursor handles GET /api/v1/news/cursor
+// @Summary Get news page by cursor
+// @Description Retrieves news as GET /api/v1/news after cursor (keyset pagination) together with cursor of the next page.
+// @Description Empty or absent cursor starts from the latest news. Next page cursor is also set in X-Next-Cursor and Link headers
+// @Tags news
+// @Produce json
+// @Param tagId query int false "Filter by tag ID"
+// @Param categoryId query int false "Filter by category ID"
+// @Param pageSize query int false "Page size (default: 10)"
+// @Param cursor query string false "Opaque cursor from nextCursor"
+// @Success 200 {object} rest.NewsCursorPage
+// @Header 200 {string} X-Next-Cursor "Cursor of the next page, absent on the last page"
+// @Header 200 {string} Link "URL of the next page with rel=next, absent on the last page"
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/news/cursor [get]
+func (h *NewsHandler) NewsByCursor(c echo.Context) error {
+	var req NewsRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	list, next, err := h.cursorPage(c, req)
+	if err != nil {
+		return h.handleCursorError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, NewNewsCursorPage(list, next))
+}
+
+// cursorPage loads news page after request cursor, sets validators and next page cursor headers.
+func (h *NewsHandler) cursorPage(c echo.Context, req NewsRequest) ([]newsportal.News, string, error) {
+	var cursor string
+	if req.Cursor != nil {
+		cursor = *req.Cursor
+	}
+
+	list, next, err := h.uc.NewsByCursor(c.Request().Context(), req.TagID, req.CategoryID, cursor, req.PageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if next != "" {
+		u := *c.Request().URL
+		q := u.Query()
+		q.Set("cursor", next)
+		u.RawQuery = q.Encode()
+
+		c.Response().Header().Set(headerNextCursor, next)
+		c.Response().Header().Set("Link", "<"+u.String()+`>; rel="next"`)
+	}
+	setNewsValidators(c, list)
+
+	return list, next, nil
+}
+
+func (h *NewsHandler) handleCursorError(c echo.Context, err error) error {
+	var vErr newsportal.ValidationError
+	if errors.As(err, &vErr) {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid cursor")
+	}
+
+	return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+}
+
+// SearchNews handles GET /api/v1/news/search
+// @Summary Search news
+// @Description Full-text search over news title and content with optional filtering by tagId and categoryId, with pagination. Returns NewsSummary (without content) ranked by relevance, then sorted by publishedAt DESC, newsId DESC
+// @Tags news
+// @Produce json
+// @Param q query string true "Search query, supports quoted phrases, or and -"
+// @Param tagId query int false "Filter by tag ID"
+// @Param categoryId query int false "Filter by category ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param pageSize query int false "Page size (default: 10)"
+// @Success 200 {array} rest.NewsSummary
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/news/search [get]
+func (h *NewsHandler) SearchNews(c echo.Context) error {
+	var req NewsSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		return h.handleError(c, nil, http.StatusBadRequest, "query is required")
+	}
+
+	newsportalSummaries, err := h.uc.SearchNews(
+		c.Request().Context(), req.Query, req.TagID, req.CategoryID, req.Page, req.PageSize,
+	)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	return c.JSON(http.StatusOK, NewNewsSummaries(newsportalSummaries))
+}
+
+// NewsPage handles GET /api/v1/news/page
+// @Summary Get news page with total
+// @Description Retrieves news as GET /api/v1/news together with total count of news matching filter. List and count are loaded in one transaction
+// @Tags news
+// @Produce json
+// @Param tagId query int false "Filter by tag ID"
+// @Param categoryId query int false "Filter by category ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param pageSize query int false "Page size (default: 10)"
+// @Success 200 {object} rest.NewsPage
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/news/page [get]
+func (h *NewsHandler) NewsPage(c echo.Context) error {
+	var req NewsRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	page, err := h.uc.NewsPage(c.Request().Context(), req.TagID, req.CategoryID, req.Page, req.PageSize)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	setNewsValidators(c, page.Items, page.Total)
+
+	return c.JSON(http.StatusOK, NewNewsPage(*page))
+}
+
+// NewsCount handles GET /api/v1/news/count
 // @Summary Get news count
 // @Description Returns the count of news matching the optional tagId and categoryId filters
 // @Tags news
@@ -77,7 +251,7 @@ func (h *NewsHandler) News(c echo.Context) error {
 // @Param categoryId query int false "Filter by category ID"
 // @Success 200 {integer} int
 // @Failure 400,500 {object} map[string]string
-// @Router /api/v1/count [get]
+// @Router /api/v1/news/count [get]
 func (h *NewsHandler) NewsCount(c echo.Context) error {
 	var req NewsCountRequest
 	if err := c.Bind(&req); err != nil {
@@ -119,6 +293,7 @@ func (h *NewsHandler) NewsByID(c echo.Context) error {
 	if newsportalNews == nil {
 		return c.String(http.StatusNotFound, "news not found")
 	}
+	setNewsValidators(c, newsportal.NewsList{*newsportalNews})
 
 	return c.JSON(http.StatusOK, NewNews(*newsportalNews))
 }
@@ -21,6 +21,8 @@ import (
 var (
 	testDB      *pg.DB
 	testHandler *NewsHandler
+	// testAuth is enabled auth of testHandler, writes are forbidden without auth
+	testAuth *newsportal.AuthManager
 )
 
 func TestMain(m *testing.M) {
@@ -69,7 +71,8 @@ func TestMain(m *testing.M) {
 	testRepo := db.New(testDB)
 	testManager := newsportal.NewNewsManager(testRepo)
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-	testHandler = NewNewsHandler(testManager, logger)
+	testAuth = newsportal.NewAuthManager(testDB, newsportal.AuthConfig{Enabled: true, JWTSecret: "test-secret"})
+	testHandler = NewNewsHandler(testManager, logger).WithAuth(testAuth)
 
 	code := m.Run()
 
@@ -407,3 +410,139 @@ func TestNewsHandler_Tags_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestNewsHandler_SearchNews_Integration(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		e := testHandler.RegisterRoutes()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/search?q=quantum", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var summaries []NewsSummary
+		err := json.Unmarshal(rec.Body.Bytes(), &summaries)
+		require.NoError(t, err, "failed to unmarshal response")
+
+		require.NotEmpty(t, summaries, "expected news items matching query")
+		assert.Contains(t, summaries[0].Title, "Quantum", "expected best ranked news first")
+		for _, summary := range summaries {
+			assert.NotNil(t, summary.Snippet, "expected snippet in search results")
+		}
+	})
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		e := testHandler.RegisterRoutes()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/search?q=", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code, "expected status 400")
+
+		var response map[string]string
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err, "failed to unmarshal response")
+
+		assert.Equal(t, "query is required", response["error"], "expected error message to match")
+	})
+}
+
+func TestNewsHandler_News_Cursor_Integration(t *testing.T) {
+	e := testHandler.RegisterRoutes()
+
+	t.Run("FollowsNextCursor", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news?cursor=&pageSize=2", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+		next := rec.Header().Get("X-Next-Cursor")
+		require.NotEmpty(t, next, "expected next cursor")
+		assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+
+		var first []NewsSummary
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &first))
+		require.Len(t, first, 2)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/news?pageSize=2&cursor="+next, nil)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var second []NewsSummary
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &second))
+		require.NotEmpty(t, second)
+		assert.NotEqual(t, first[0].NewsID, second[0].NewsID, "expected next page")
+		assert.False(t, second[0].PublishedAt.After(first[1].PublishedAt), "expected publishedAt DESC order")
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news?cursor=invalid", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code, "expected status 400")
+	})
+}
+
+func TestNewsHandler_NewsByCursor_Integration(t *testing.T) {
+	e := testHandler.RegisterRoutes()
+
+	do := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("FollowsNextCursor", func(t *testing.T) {
+		rec := do("/api/v1/news/cursor?pageSize=2")
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var first NewsCursorPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &first))
+		require.Len(t, first.Items, 2)
+		require.NotNil(t, first.NextCursor, "expected next cursor")
+		assert.Equal(t, *first.NextCursor, rec.Header().Get("X-Next-Cursor"))
+
+		rec = do("/api/v1/news/cursor?pageSize=2&cursor=" + *first.NextCursor)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var second NewsCursorPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &second))
+		require.NotEmpty(t, second.Items)
+		assert.NotEqual(t, first.Items[0].NewsID, second.Items[0].NewsID, "expected next page")
+	})
+
+	t.Run("LastPage", func(t *testing.T) {
+		rec := do("/api/v1/news/cursor?pageSize=100")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var page NewsCursorPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		assert.Nil(t, page.NextCursor, "expected no next cursor on the last page")
+		assert.Empty(t, rec.Header().Get("X-Next-Cursor"))
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		rec := do("/api/v1/news/cursor?cursor=invalid")
+		assert.Equal(t, http.StatusBadRequest, rec.Code, "expected status 400")
+	})
+}
+
+func TestNewsHandler_NewsPage_Integration(t *testing.T) {
+	e := testHandler.RegisterRoutes()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/news/page?page=1&pageSize=2", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+	var page NewsPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page), "failed to unmarshal response")
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, 1, page.Page)
+	assert.Equal(t, 2, page.PageSize)
+	assert.Greater(t, page.Total, 2)
+	assert.True(t, page.HasNext)
+}
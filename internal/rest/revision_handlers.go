@@ -0,0 +1,144 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NewsRevisionsRequest struct {
+	Page     *int `query:"page"`
+	PageSize *int `query:"pageSize"`
+}
+
+type NewsRevisionDiffRequest struct {
+	From int `query:"from"`
+	To   int `query:"to"`
+}
+
+// NewsRevisions handles GET /api/v1/news/:id/revisions
+// @Summary Get news revisions
+// @Description Retrieves revisions of news item sorted by createdAt DESC. Revision is added on every create and update
+// @Tags revisions
+// @Produce json
+// @Param id path int true "News ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param pageSize query int false "Page size (default: 10)"
+// @Success 200 {array} rest.NewsRevision
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/news/{id}/revisions [get]
+func (h *NewsHandler) NewsRevisions(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req NewsRevisionsRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	list, err := h.uc.NewsRevisions(c.Request().Context(), id, req.Page, req.PageSize)
+	if err != nil {
+		return h.handleEditError(c, err, "news not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNewsRevisions(list))
+}
+
+// NewsRevision handles GET /api/v1/news/:id/revisions/:revisionId
+// @Summary Get news revision
+// @Description Retrieves revision of news item
+// @Tags revisions
+// @Produce json
+// @Param id path int true "News ID"
+// @Param revisionId path int true "Revision ID"
+// @Success 200 {object} rest.NewsRevision
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/news/{id}/revisions/{revisionId} [get]
+func (h *NewsHandler) NewsRevision(c echo.Context) error {
+	id, revisionID, err := revisionPathIDs(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	revision, err := h.uc.NewsRevision(c.Request().Context(), id, revisionID)
+	if err != nil {
+		return h.handleEditError(c, err, "revision not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNewsRevision(*revision))
+}
+
+// NewsRevisionDiff handles GET /api/v1/news/:id/revisions/diff
+// @Summary Diff news revisions
+// @Description Returns changed title, category and tags and line diff of content between two revisions of news item
+// @Tags revisions
+// @Produce json
+// @Param id path int true "News ID"
+// @Param from query int true "Old revision ID"
+// @Param to query int true "New revision ID"
+// @Success 200 {object} rest.NewsRevisionDiff
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/news/{id}/revisions/diff [get]
+func (h *NewsHandler) NewsRevisionDiff(c echo.Context) error {
+	id, err := pathID(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	var req NewsRevisionDiffRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	} else if req.From <= 0 || req.To <= 0 {
+		return h.handleError(c, errors.New("from and to must be positive"), http.StatusBadRequest, "invalid revision ids")
+	}
+
+	diff, err := h.uc.NewsRevisionDiff(c.Request().Context(), id, req.From, req.To)
+	if err != nil {
+		return h.handleEditError(c, err, "revision not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNewsRevisionDiff(*diff))
+}
+
+// RestoreNewsRevision handles POST /api/v1/news/:id/revisions/:revisionId/restore
+// @Summary Restore news revision
+// @Description Updates news item with title, content, category and tags of revision. It adds a new revision
+// @Tags revisions
+// @Produce json
+// @Param id path int true "News ID"
+// @Param revisionId path int true "Revision ID"
+// @Success 200 {object} rest.News
+// @Failure 400,404,500 {object} map[string]string
+// @Failure 422 {object} map[string]string "Field errors"
+// @Router /api/v1/news/{id}/revisions/{revisionId}/restore [post]
+func (h *NewsHandler) RestoreNewsRevision(c echo.Context) error {
+	id, revisionID, err := revisionPathIDs(c)
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid id")
+	}
+
+	news, err := h.uc.RestoreNewsRevision(c.Request().Context(), id, revisionID)
+	if err != nil {
+		return h.handleEditError(c, err, "news or revision not found")
+	}
+
+	return c.JSON(http.StatusOK, NewNews(*news))
+}
+
+// revisionPathIDs returns positive news and revision ids from path.
+func revisionPathIDs(c echo.Context) (int, int, error) {
+	id, err := pathID(c)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	revisionID, err := pathParamID(c, "revisionId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, revisionID, nil
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandler_NewsRevisions_Integration(t *testing.T) {
+	body := `{"categoryId":1,"title":"Revised REST News","content":"first\nsecond","author":"Editor","publishedAt":"2024-01-10T12:00:00Z","tagIds":[1],"statusId":1}`
+
+	rec := doJSON(t, http.MethodPost, "/api/v1/news", body)
+	require.Equal(t, http.StatusCreated, rec.Code, "expected status 201, body: %s", rec.Body.String())
+
+	var news News
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &news), "failed to unmarshal response")
+	base := fmt.Sprintf("/api/v1/news/%d/revisions", news.NewsID)
+
+	rec = doJSON(t, http.MethodPatch, fmt.Sprintf("/api/v1/news/%d", news.NewsID), `{"title":"Revised REST News Updated","content":"first\nthird"}`)
+	require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+	var revisions []NewsRevision
+	t.Run("List", func(t *testing.T) {
+		rec := doJSON(t, http.MethodGet, base, "")
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revisions), "failed to unmarshal response")
+		require.Len(t, revisions, 2)
+		assert.Equal(t, "Revised REST News Updated", revisions[0].Title)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		require.Len(t, revisions, 2, "revisions were not loaded")
+		rec := doJSON(t, http.MethodGet, fmt.Sprintf("%s/%d", base, revisions[1].NewsRevisionID), "")
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var revision NewsRevision
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revision), "failed to unmarshal response")
+		assert.Equal(t, "Revised REST News", revision.Title)
+
+		rec = doJSON(t, http.MethodGet, base+"/99999", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		require.Len(t, revisions, 2, "revisions were not loaded")
+		rec := doJSON(t, http.MethodGet, fmt.Sprintf("%s/diff?from=%d&to=%d", base, revisions[1].NewsRevisionID, revisions[0].NewsRevisionID), "")
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var diff NewsRevisionDiff
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &diff), "failed to unmarshal response")
+		assert.Contains(t, diff.Fields, "title")
+		assert.Equal(t, []DiffLine{{"=", "first"}, {"-", "second"}, {"+", "third"}}, diff.Content)
+
+		rec = doJSON(t, http.MethodGet, base+"/diff", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		require.Len(t, revisions, 2, "revisions were not loaded")
+		rec := doJSON(t, http.MethodPost, fmt.Sprintf("%s/%d/restore", base, revisions[1].NewsRevisionID), "")
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var restored News
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &restored), "failed to unmarshal response")
+		assert.Equal(t, "Revised REST News", restored.Title)
+		assert.Equal(t, "first\nsecond", restored.Content)
+	})
+}
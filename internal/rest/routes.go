@@ -1,11 +1,13 @@
 package rest
 
 import (
+	"log/slog"
 	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
@@ -16,15 +18,15 @@ const (
 	indexHTML   = "index.html"
 )
 
+// RegisterRoutes returns standalone echo server with REST API, feeds, sitemaps, health check and frontend routes.
 func (h *NewsHandler) RegisterRoutes() *echo.Echo {
 	e := echo.New()
 
 	// Middleware
-	e.Use(h.loggingMiddleware)
+	e.Use(RequestLogger(h.log))
 	e.Use(middleware.Recover())
 
-	// API routes
-	h.registerAPIRoutes(e)
+	h.Register(e)
 
 	// Health check
 	h.registerHealthCheck(e)
@@ -35,12 +37,85 @@ func (h *NewsHandler) RegisterRoutes() *echo.Echo {
 	return e
 }
 
-func (h *NewsHandler) registerAPIRoutes(e *echo.Echo) {
-	e.GET("/api/v1/news", h.News)
-	e.GET("/api/v1/news/count", h.NewsCount)
-	e.GET("/api/v1/news/:id", h.NewsByID)
-	e.GET("/api/v1/categories", h.Categories)
-	e.GET("/api/v1/tags", h.Tags)
+// Register registers REST API, feed and sitemap routes on e, so they can be served next to other routes,
+// e.g. JSON-RPC. Routes authenticate requests themselves, common middleware is set up by caller.
+func (h *NewsHandler) Register(e *echo.Echo) {
+	auth := Authenticate(h.auth)
+
+	// Read routes check read role and have ETag and Cache-Control, write routes require editor role
+	read := []echo.MiddlewareFunc{auth, RequireRole(h.auth, h.readRole()), HTTPCache(h.httpCache, IsGET)}
+	write := []echo.MiddlewareFunc{auth, RequireRole(h.auth, newsportal.RoleEditor)}
+
+	// API routes
+	h.registerAPIRoutes(e, read, write)
+
+	// Feeds
+	h.registerFeedRoutes(e, read)
+
+	// Sitemaps
+	h.registerSitemapRoutes(e, read)
+}
+
+func (h *NewsHandler) registerAPIRoutes(e *echo.Echo, read, write []echo.MiddlewareFunc) {
+	e.GET("/api/v1/news", h.News, read...)
+	e.GET("/api/v1/news/count", h.NewsCount, read...)
+	e.GET("/api/v1/news/page", h.NewsPage, read...)
+	e.GET("/api/v1/news/cursor", h.NewsByCursor, read...)
+	e.GET("/api/v1/news/search", h.SearchNews, read...)
+	if h.stream != nil {
+		// stream is not buffered by HTTP cache, it is the last read middleware
+		e.GET("/api/v1/news/stream", h.NewsStream, read[:2]...)
+	}
+	e.GET("/api/v1/news/:id", h.NewsByID, read...)
+	e.POST("/api/v1/news", h.CreateNews, write...)
+	e.PUT("/api/v1/news/:id", h.UpdateNews, write...)
+	e.PATCH("/api/v1/news/:id", h.PatchNews, write...)
+	e.DELETE("/api/v1/news/:id", h.DeleteNews, write...)
+
+	// Revisions contain unpublished content and require editor role
+	e.GET("/api/v1/news/:id/revisions", h.NewsRevisions, write...)
+	e.GET("/api/v1/news/:id/revisions/diff", h.NewsRevisionDiff, write...)
+	e.GET("/api/v1/news/:id/revisions/:revisionId", h.NewsRevision, write...)
+	e.POST("/api/v1/news/:id/revisions/:revisionId/restore", h.RestoreNewsRevision, write...)
+
+	e.GET("/api/v1/categories", h.Categories, read...)
+	e.GET("/api/v1/categories/:id", h.CategoryByID, read...)
+	e.POST("/api/v1/categories", h.CreateCategory, write...)
+	e.PUT("/api/v1/categories/:id", h.UpdateCategory, write...)
+	e.PATCH("/api/v1/categories/:id", h.PatchCategory, write...)
+	e.DELETE("/api/v1/categories/:id", h.DeleteCategory, write...)
+
+	e.GET("/api/v1/tags", h.Tags, read...)
+	e.GET("/api/v1/tags/:id", h.TagByID, read...)
+	e.POST("/api/v1/tags", h.CreateTag, write...)
+	e.PUT("/api/v1/tags/:id", h.UpdateTag, write...)
+	e.PATCH("/api/v1/tags/:id", h.PatchTag, write...)
+	e.DELETE("/api/v1/tags/:id", h.DeleteTag, write...)
+}
+
+// registerFeedRoutes registers feeds. Category and tag feed params include .xml or .json extension, e.g. /feed/tag/1.xml.
+func (h *NewsHandler) registerFeedRoutes(e *echo.Echo, read []echo.MiddlewareFunc) {
+	e.GET("/feed/rss.xml", h.RSSFeed, read...)
+	e.GET("/feed/atom.xml", h.AtomFeed, read...)
+	e.GET("/feed/feed.json", h.JSONFeed, read...)
+	e.GET("/feed/category/:id", h.CategoryFeed, read...)
+	e.GET("/feed/tag/:id", h.TagFeed, read...)
+}
+
+// registerSitemapRoutes registers sitemaps. Sitemap page param includes .xml extension, e.g. /sitemap/1.xml.
+func (h *NewsHandler) registerSitemapRoutes(e *echo.Echo, read []echo.MiddlewareFunc) {
+	e.GET("/sitemap.xml", h.SitemapIndex, read...)
+	e.GET("/sitemap/:id", h.Sitemap, read...)
+	e.GET("/sitemap-news.xml", h.NewsSitemap, read...)
+}
+
+// readRole returns role required for read routes, reads are public without enabled auth.
+func (h *NewsHandler) readRole() newsportal.Role {
+	if !h.auth.Enabled() {
+		return ""
+	}
+
+	return h.auth.Config().ReadRole
 }
 
 func (h *NewsHandler) registerHealthCheck(e *echo.Echo) {
@@ -72,26 +147,29 @@ func (h *NewsHandler) handleHealth(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
 
-func (h *NewsHandler) loggingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(c echo.Context) error {
-		start := time.Now()
-
-		err := next(c)
-
-		duration := time.Since(start)
-		status := c.Response().Status
-		if status == 0 {
-			status = http.StatusOK
+// RequestLogger returns middleware that logs method, path, status and duration of every request.
+func RequestLogger(log *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			err := next(c)
+
+			duration := time.Since(start)
+			status := c.Response().Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			log.Info("HTTP request",
+				"method", c.Request().Method,
+				"path", c.Request().URL.Path,
+				"status", status,
+				"duration_ms", duration.Milliseconds(),
+				"remote_addr", c.Request().RemoteAddr,
+			)
+
+			return err
 		}
-
-		h.log.Info("HTTP request",
-			"method", c.Request().Method,
-			"path", c.Request().URL.Path,
-			"status", status,
-			"duration_ms", duration.Milliseconds(),
-			"remote_addr", c.Request().RemoteAddr,
-		)
-
-		return err
 	}
 }
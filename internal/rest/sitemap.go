@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
+
+const (
+	sitemapNamespace     = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapNewsNamespace = "http://www.google.com/schemas/sitemap-news/0.9"
+
+	// publication name and language for Google News sitemap
+	newsPublicationName     = "News Portal"
+	newsPublicationLanguage = "ru"
+)
+
+type SitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Sitemaps []SitemapEntry `xml:"sitemap"`
+}
+
+type SitemapEntry struct {
+	Loc string `xml:"loc"`
+}
+
+type SitemapURL struct {
+	XMLName xml.Name `xml:"url"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod"`
+}
+
+type NewsSitemap struct {
+	XMLName xml.Name         `xml:"urlset"`
+	Xmlns   string           `xml:"xmlns,attr"`
+	News    string           `xml:"xmlns:news,attr"`
+	URLs    []NewsSitemapURL `xml:"url"`
+}
+
+type NewsSitemapURL struct {
+	Loc  string          `xml:"loc"`
+	News NewsSitemapNews `xml:"news:news"`
+}
+
+type NewsSitemapNews struct {
+	Publication     NewsSitemapPublication `xml:"news:publication"`
+	PublicationDate string                 `xml:"news:publication_date"`
+	Title           string                 `xml:"news:title"`
+}
+
+type NewsSitemapPublication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+// NewSitemapIndex returns sitemap index with paged sitemaps and Google News sitemap.
+func NewSitemapIndex(baseURL string, pageCount int) SitemapIndex {
+	index := SitemapIndex{
+		Xmlns:    sitemapNamespace,
+		Sitemaps: make([]SitemapEntry, 0, pageCount+1),
+	}
+
+	for page := 1; page <= pageCount; page++ {
+		index.Sitemaps = append(index.Sitemaps, SitemapEntry{Loc: baseURL + "/sitemap/" + strconv.Itoa(page) + ".xml"})
+	}
+	index.Sitemaps = append(index.Sitemaps, SitemapEntry{Loc: baseURL + "/sitemap-news.xml"})
+
+	return index
+}
+
+// NewSitemapURL returns sitemap url of news. Lastmod is updatedAt or publishedAt.
+func NewSitemapURL(baseURL string, n newsportal.News) SitemapURL {
+	lastMod := n.PublishedAt
+	if n.UpdatedAt != nil && n.UpdatedAt.After(lastMod) {
+		lastMod = *n.UpdatedAt
+	}
+
+	return SitemapURL{
+		Loc:     newsURL(baseURL, n.ID),
+		LastMod: lastMod.UTC().Format(time.RFC3339),
+	}
+}
+
+// NewNewsSitemap returns Google News sitemap for news.
+func NewNewsSitemap(baseURL string, list newsportal.NewsList) NewsSitemap {
+	sitemap := NewsSitemap{
+		Xmlns: sitemapNamespace,
+		News:  sitemapNewsNamespace,
+		URLs:  make([]NewsSitemapURL, 0, len(list)),
+	}
+
+	for _, n := range list {
+		sitemap.URLs = append(sitemap.URLs, NewsSitemapURL{
+			Loc: newsURL(baseURL, n.ID),
+			News: NewsSitemapNews{
+				Publication: NewsSitemapPublication{
+					Name:     newsPublicationName,
+					Language: newsPublicationLanguage,
+				},
+				PublicationDate: n.PublishedAt.UTC().Format(time.RFC3339),
+				Title:           n.Title,
+			},
+		})
+	}
+
+	return sitemap
+}
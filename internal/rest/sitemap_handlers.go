@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	mimeXML = "application/xml; charset=UTF-8"
+
+	// recentNewsPeriod is a Google News sitemap period.
+	recentNewsPeriod = 48 * time.Hour
+)
+
+// SitemapIndex handles GET /sitemap.xml
+// @Summary Sitemap index
+// @Description Returns sitemap index with paged news sitemaps and Google News sitemap
+// @Tags sitemaps
+// @Produce xml
+// @Success 200 {string} string "Sitemap index"
+// @Failure 500 {object} map[string]string
+// @Router /sitemap.xml [get]
+func (h *NewsHandler) SitemapIndex(c echo.Context) error {
+	count, err := h.uc.SitemapPageCount(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	return h.writeXML(c, NewSitemapIndex(baseURL(c), count))
+}
+
+// Sitemap handles GET /sitemap/:id.xml
+// @Summary Sitemap page
+// @Description Returns sitemap page of visible news with lastmod from updatedAt or publishedAt. Page is a newsId range, news are streamed in batches
+// @Tags sitemaps
+// @Produce xml
+// @Param id path int true "Page number"
+// @Success 200 {string} string "Sitemap"
+// @Failure 400,404,500 {object} map[string]string
+// @Router /sitemap/{id}.xml [get]
+func (h *NewsHandler) Sitemap(c echo.Context) error {
+	page, _, err := pathIDExt(c, ".xml")
+	if err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid page")
+	}
+
+	ctx := c.Request().Context()
+	count, err := h.uc.SitemapPageCount(ctx)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	} else if page > count {
+		return h.handleError(c, nil, http.StatusNotFound, "sitemap not found")
+	}
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, mimeXML)
+	w.WriteHeader(http.StatusOK)
+
+	enc := xml.NewEncoder(w)
+	urlset := xml.StartElement{
+		Name: xml.Name{Local: "urlset"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: sitemapNamespace}},
+	}
+
+	if _, err = w.Write([]byte(xml.Header)); err == nil {
+		err = enc.EncodeToken(urlset)
+	}
+
+	base := baseURL(c)
+	if err == nil {
+		err = h.uc.SitemapNews(ctx, page, func(list newsportal.NewsList) error {
+			for _, n := range list {
+				if err := enc.Encode(NewSitemapURL(base, n)); err != nil {
+					return err
+				}
+			}
+
+			// send batch to client
+			if err := enc.Flush(); err != nil {
+				return err
+			}
+			w.Flush()
+
+			return nil
+		})
+	}
+
+	if err == nil {
+		err = enc.EncodeToken(urlset.End())
+	}
+	if err == nil {
+		err = enc.Flush()
+	}
+
+	// response is already committed, only log error
+	if err != nil {
+		h.log.Error("failed to write sitemap", "page", page, "error", err)
+	}
+
+	return nil
+}
+
+// NewsSitemap handles GET /sitemap-news.xml
+// @Summary Google News sitemap
+// @Description Returns Google News sitemap of visible news published in the last 48 hours
+// @Tags sitemaps
+// @Produce xml
+// @Success 200 {string} string "Google News sitemap"
+// @Failure 500 {object} map[string]string
+// @Router /sitemap-news.xml [get]
+func (h *NewsHandler) NewsSitemap(c echo.Context) error {
+	list, err := h.uc.RecentNews(c.Request().Context(), time.Now().Add(-recentNewsPeriod))
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	return h.writeXML(c, NewNewsSitemap(baseURL(c), list))
+}
+
+// writeXML writes 200 response with XML document.
+func (h *NewsHandler) writeXML(c echo.Context, doc any) error {
+	body, err := xml.Marshal(doc)
+	if err != nil {
+		return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+	}
+
+	return c.Blob(http.StatusOK, mimeXML, append([]byte(xml.Header), body...))
+}
@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandler_Sitemap_Integration(t *testing.T) {
+	t.Run("Index", func(t *testing.T) {
+		rec := doGet(t, "/sitemap.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var index SitemapIndex
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &index), "failed to unmarshal sitemap index")
+		require.Len(t, index.Sitemaps, 2, "expected one news page and news sitemap")
+		assert.Equal(t, "http://example.com/sitemap/1.xml", index.Sitemaps[0].Loc)
+		assert.Equal(t, "http://example.com/sitemap-news.xml", index.Sitemaps[1].Loc)
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		rec := doGet(t, "/sitemap/1.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+
+		var urlset struct {
+			URLs []SitemapURL `xml:"url"`
+		}
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &urlset), "failed to unmarshal sitemap")
+		require.NotEmpty(t, urlset.URLs, "expected sitemap urls")
+		assert.Contains(t, urlset.URLs[0].Loc, "http://example.com/api/v1/news/")
+		assert.NotEmpty(t, urlset.URLs[0].LastMod)
+	})
+
+	t.Run("PageNotFound", func(t *testing.T) {
+		rec := doGet(t, "/sitemap/1000.xml", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code, "expected status 404")
+	})
+
+	t.Run("News", func(t *testing.T) {
+		rec := doGet(t, "/sitemap-news.xml", nil)
+		require.Equal(t, http.StatusOK, rec.Code, "expected status 200, body: %s", rec.Body.String())
+		assert.Contains(t, rec.Body.String(), `xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"`)
+	})
+}
@@ -0,0 +1,125 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// headerLastEventID contains id of the last received event, EventSource sends it on reconnect.
+	headerLastEventID = "Last-Event-ID"
+
+	// streamResumeLimit is a max number of missed news sent on resume.
+	streamResumeLimit = 100
+)
+
+type NewsStreamRequest struct {
+	TagID      *int `query:"tagId"`
+	CategoryID *int `query:"categoryId"`
+}
+
+// WithStream enables GET /api/v1/news/stream with news from stream.
+func (h *NewsHandler) WithStream(stream *newsportal.NewsStream) *NewsHandler {
+	h.stream = stream
+	return h
+}
+
+// NewsStream handles GET /api/v1/news/stream
+// @Summary Stream published news
+// @Description Server-Sent Events stream of news becoming visible on the portal with optional filtering by tagId and categoryId. Every "news" event contains NewsSummary in data and news cursor in id.
+// @Description On reconnect with Last-Event-ID header news published after the last received one are sent first, up to 100 news. Comment lines are sent periodically to keep connection alive
+// @Tags news
+// @Produce text/event-stream
+// @Param tagId query int false "Filter by tag ID"
+// @Param categoryId query int false "Filter by category ID"
+// @Param Last-Event-ID header string false "Id of the last received event"
+// @Success 200 {object} rest.NewsSummary "Event data"
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/news/stream [get]
+func (h *NewsHandler) NewsStream(c echo.Context) error {
+	var req NewsStreamRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, err, http.StatusBadRequest, "invalid request parameters")
+	}
+
+	ctx := c.Request().Context()
+
+	// subscribe before loading missed news, so news published in between are not lost
+	sub := h.stream.Subscribe(newsportal.NewsFilter{TagID: req.TagID, CategoryID: req.CategoryID}, newsportal.EventNewsPublished)
+	defer h.stream.Unsubscribe(sub)
+
+	var missed []newsportal.News
+	if id := c.Request().Header.Get(headerLastEventID); id != "" {
+		last, err := newsportal.ParseCursor(id)
+		if err != nil {
+			return h.handleError(c, err, http.StatusBadRequest, "invalid Last-Event-ID")
+		}
+
+		missed, err = h.uc.NewsAfter(ctx, req.TagID, req.CategoryID, last, streamResumeLimit)
+		if err != nil {
+			return h.handleError(c, err, http.StatusInternalServerError, "internal error")
+		}
+	}
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	sent := make(map[int]struct{}, len(missed))
+	for _, n := range missed {
+		if err := writeNewsEvent(w, n); err != nil {
+			return nil
+		}
+		sent[n.ID] = struct{}{}
+	}
+
+	heartbeat := time.NewTicker(h.stream.Config().Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+			w.Flush()
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if _, ok := sent[e.News.ID]; ok {
+				continue
+			}
+
+			if err := writeNewsEvent(w, e.News); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// writeNewsEvent writes news event with NewsSummary data and news cursor id.
+func writeNewsEvent(w *echo.Response, n newsportal.News) error {
+	data, err := json.Marshal(NewNewsSummary(n))
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "id: %s\nevent: news\ndata: %s\n\n", newsportal.NewCursor(n), data); err != nil {
+		return err
+	}
+	w.Flush()
+
+	return nil
+}
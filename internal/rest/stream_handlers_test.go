@@ -0,0 +1,65 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsHandler_NewsStream_Integration(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	stream := newsportal.NewNewsStream(testHandler.uc, testDB, logger, newsportal.StreamConfig{})
+	e := NewNewsHandler(testHandler.uc, logger).WithStream(stream).RegisterRoutes()
+
+	// stream request returns when client disconnects
+	doStream := func(t *testing.T, target, lastEventID string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		req := httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx)
+		if lastEventID != "" {
+			req.Header.Set(headerLastEventID, lastEventID)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("Live", func(t *testing.T) {
+		rec := doStream(t, "/api/v1/news/stream", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Empty(t, rec.Body.String(), "expected no events without Last-Event-ID")
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		all, err := testHandler.uc.NewsAfter(context.Background(), nil, nil, newsportal.Cursor{}, 100)
+		require.NoError(t, err)
+		require.Greater(t, len(all), 1, "expected test data")
+
+		rec := doStream(t, "/api/v1/news/stream", newsportal.NewCursor(all[0]).String())
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Equal(t, len(all)-1, strings.Count(body, "event: news\n"))
+		assert.NotContains(t, body, "id: "+newsportal.NewCursor(all[0]).String()+"\n")
+		assert.Contains(t, body, "id: "+newsportal.NewCursor(all[1]).String()+"\nevent: news\ndata: {")
+	})
+
+	t.Run("InvalidLastEventID", func(t *testing.T) {
+		rec := doStream(t, "/api/v1/news/stream", "invalid")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
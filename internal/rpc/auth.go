@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const authNamespace = "auth"
+
+// methodRoles are roles required for methods. Other methods are read methods and require AuthConfig.ReadRole.
+var methodRoles = map[string]newsportal.Role{
+	newsMethod(RPC.NewsService.Create):     newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.Update):     newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.Delete):     newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.AuditLog):   newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.Transition): newsportal.RoleEditor,
+
+	newsMethod(RPC.NewsService.Revisions):       newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.Revision):        newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.RevisionDiff):    newsportal.RoleEditor,
+	newsMethod(RPC.NewsService.RestoreRevision): newsportal.RoleEditor,
+
+	authMethod(RPC.AuthService.Principal):    newsportal.RoleReader,
+	authMethod(RPC.AuthService.APIKeys):      newsportal.RoleAdmin,
+	authMethod(RPC.AuthService.CreateAPIKey): newsportal.RoleAdmin,
+	authMethod(RPC.AuthService.DeleteAPIKey): newsportal.RoleAdmin,
+
+	webhookMethod(RPC.WebhookService.List):       newsportal.RoleAdmin,
+	webhookMethod(RPC.WebhookService.Create):     newsportal.RoleAdmin,
+	webhookMethod(RPC.WebhookService.Disable):    newsportal.RoleAdmin,
+	webhookMethod(RPC.WebhookService.Deliveries): newsportal.RoleAdmin,
+	webhookMethod(RPC.WebhookService.Redeliver):  newsportal.RoleAdmin,
+}
+
+func authMethod(method string) string {
+	return authNamespace + "." + method
+}
+
+// Auth returns middleware that authenticates request by X-API-Key or Authorization: Bearer headers,
+// puts principal into context and checks method role. Without enabled auth read methods are allowed
+// and methods of methodRoles are forbidden.
+func Auth(auth *newsportal.AuthManager) zenrpc.MiddlewareFunc {
+	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
+		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+			required, ok := methodRoles[strings.ToLower(zenrpc.NamespaceFromContext(ctx)+"."+method)]
+			if !auth.Enabled() {
+				if ok {
+					return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), authErrorCode(newsportal.ErrAuthDisabled), authErrorMessage(newsportal.ErrAuthDisabled), nil)
+				}
+				return h(ctx, method, params)
+			}
+
+			if r, ok := zenrpc.RequestFromContext(ctx); ok {
+				p, err := auth.AuthenticateRequest(ctx, r)
+				if err != nil {
+					return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), authErrorCode(err), authErrorMessage(err), nil)
+				} else if p != nil {
+					ctx = newsportal.WithPrincipal(ctx, p)
+				}
+			}
+
+			if !ok {
+				required = auth.Config().ReadRole
+			}
+
+			if err := newsportal.RequireRole(ctx, required); err != nil {
+				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), authErrorCode(err), authErrorMessage(err), nil)
+			}
+
+			return h(ctx, method, params)
+		}
+	}
+}
+
+// authErrorCode returns 401 or 403 code for auth errors and 500 for others.
+func authErrorCode(err error) int {
+	switch {
+	case errors.Is(err, newsportal.ErrUnauthorized):
+		return 401
+	case errors.Is(err, newsportal.ErrForbidden):
+		return 403
+	}
+
+	return 500
+}
+
+func authErrorMessage(err error) string {
+	switch authErrorCode(err) {
+	case 401:
+		return "unauthorized"
+	case 403:
+		return "forbidden"
+	}
+
+	return "internal server error"
+}
+
+// AuthService provides RPC methods for API keys management.
+type AuthService struct {
+	zenrpc.Service
+	auth *newsportal.AuthManager
+}
+
+func NewAuthService(auth *newsportal.AuthManager) *AuthService {
+	return &AuthService{auth: auth}
+}
+
+// Principal returns authenticated client.
+//
+//zenrpc:401 unauthorized
+func (s *AuthService) Principal(ctx context.Context) (*Principal, error) {
+	p := newsportal.PrincipalFromContext(ctx)
+	if p == nil {
+		return nil, zenrpc.NewStringError(401, "unauthorized")
+	}
+
+	result := NewPrincipal(*p)
+	return &result, nil
+}
+
+// APIKeys returns API keys, the newest first. Key values are not returned.
+//
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *AuthService) APIKeys(ctx context.Context) ([]APIKey, error) {
+	list, err := s.auth.APIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAPIKeys(list), nil
+}
+
+// CreateAPIKey adds API key with role. Key value is returned only once.
+//
+//zenrpc:apiKey API key data
+//zenrpc:return created API key with key value
+//zenrpc:400 validation failed
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *AuthService) CreateAPIKey(ctx context.Context, apiKey APIKeyInput) (*CreatedAPIKey, error) {
+	key, created, err := s.auth.CreateAPIKey(ctx, apiKey.Title, newsportal.Role(apiKey.Role), apiKey.ExpiresAt)
+	if err != nil {
+		return nil, newManagerError(err, "api key not found")
+	}
+
+	return &CreatedAPIKey{APIKey: NewAPIKey(*created), Key: key}, nil
+}
+
+// DeleteAPIKey marks API key as deleted.
+//
+//zenrpc:id API key numeric ID
+//zenrpc:400 id must be positive
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 api key not found
+//zenrpc:500 internal server error
+func (s *AuthService) DeleteAPIKey(ctx context.Context, id int) (bool, error) {
+	if id <= 0 {
+		return false, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	if err := s.auth.DeleteAPIKey(ctx, id); err != nil {
+		return false, newManagerError(err, "api key not found")
+	}
+
+	return true, nil
+}
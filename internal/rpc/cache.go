@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+// MaxRequestSize is a max size of /rpc request body.
+const MaxRequestSize = 1 << 20
+
+// idempotentMethods are read only methods which responses can be cached.
+var idempotentMethods = map[string]struct{}{
+	newsMethod(RPC.NewsService.List):         {},
+	newsMethod(RPC.NewsService.Page):         {},
+	newsMethod(RPC.NewsService.ListByCursor): {},
+	newsMethod(RPC.NewsService.Search):       {},
+	newsMethod(RPC.NewsService.Feed):         {},
+	newsMethod(RPC.NewsService.Count):        {},
+	newsMethod(RPC.NewsService.ByID):         {},
+	newsMethod(RPC.NewsService.Categories):   {},
+	newsMethod(RPC.NewsService.Tags):         {},
+}
+
+func newsMethod(method string) string {
+	return newsNamespace + "." + method
+}
+
+// IsIdempotent reports whether request is a JSON-RPC call or batch of idempotent methods only.
+// Request body is read up to MaxRequestSize and restored, larger requests are not idempotent.
+func IsIdempotent(r *http.Request) bool {
+	if r.Method != http.MethodPost || r.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxRequestSize+1))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	if err != nil || len(body) > MaxRequestSize {
+		return false
+	}
+
+	var reqs []zenrpc.Request
+	if b := bytes.TrimSpace(body); len(b) > 0 && b[0] == '[' {
+		err = json.Unmarshal(b, &reqs)
+	} else {
+		reqs = make([]zenrpc.Request, 1)
+		err = json.Unmarshal(b, &reqs[0])
+	}
+	if err != nil || len(reqs) == 0 {
+		return false
+	}
+
+	for _, req := range reqs {
+		if _, ok := idempotentMethods[strings.ToLower(req.Method)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readCloser is a restored request body which closes original body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -1,8 +1,13 @@
 package rpc
 
 //go:generate colgen -imports=github.com/daniilsolovey/news-portal/internal/newsportal -funcpkg=newsportal
-//colgen:News,Tag,Category,NewsSummary
+//colgen:News,Tag,Category,NewsSummary,APIKey,AuditLog,NewsRevision,Webhook,WebhookDelivery
 //colgen:News:Map(newsportal),Index(NewsID)
 //colgen:Category:Map(newsportal),Index(CategoryID)
 //colgen:Tag:Map(newsportal),Index(TagID)
 //colgen:NewsSummary:Map(newsportal.News)
+//colgen:APIKey:Map(newsportal)
+//colgen:AuditLog:Map(newsportal)
+//colgen:NewsRevision:Map(newsportal)
+//colgen:Webhook:Map(newsportal)
+//colgen:WebhookDelivery:Map(newsportal)
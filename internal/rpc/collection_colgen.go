@@ -5,6 +5,14 @@ import (
 	"github.com/daniilsolovey/news-portal/internal/newsportal"
 )
 
+type APIKeys []APIKey
+
+func NewAPIKeys(in []newsportal.APIKey) APIKeys { return newsportal.Map(in, NewAPIKey) }
+
+type AuditLogs []AuditLog
+
+func NewAuditLogs(in []newsportal.AuditLog) AuditLogs { return newsportal.Map(in, NewAuditLog) }
+
 type Categories []Category
 
 func NewCategories(in []newsportal.Category) Categories { return newsportal.Map(in, NewCategory) }
@@ -29,6 +37,12 @@ func (ll NewsList) IndexByNewsID() map[int]News {
 	return r
 }
 
+type NewsRevisions []NewsRevision
+
+func NewNewsRevisions(in []newsportal.NewsRevision) NewsRevisions {
+	return newsportal.Map(in, NewNewsRevision)
+}
+
 type NewsSummaries []NewsSummary
 
 func NewNewsSummaries(in []newsportal.News) NewsSummaries { return newsportal.Map(in, NewNewsSummary) }
@@ -44,3 +58,13 @@ func (ll Tags) IndexByTagID() map[int]Tag {
 	}
 	return r
 }
+
+type Webhooks []Webhook
+
+func NewWebhooks(in []newsportal.Webhook) Webhooks { return newsportal.Map(in, NewWebhook) }
+
+type WebhookDeliveries []WebhookDelivery
+
+func NewWebhookDeliveries(in []newsportal.WebhookDelivery) WebhookDeliveries {
+	return newsportal.Map(in, NewWebhookDelivery)
+}
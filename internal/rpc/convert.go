@@ -1,6 +1,17 @@
 package rpc
 
-import "github.com/daniilsolovey/news-portal/internal/newsportal"
+import (
+	"strconv"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+)
+
+const (
+	jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+	feedTitle       = "News Portal"
+	feedDescription = "Latest news"
+	feedExcerptLen  = 300
+)
 
 func NewNews(n newsportal.News) News {
 	news := News{
@@ -10,6 +21,7 @@ func NewNews(n newsportal.News) News {
 		Content:     *n.Content,
 		Author:      n.Author,
 		PublishedAt: n.PublishedAt,
+		State:       n.State().String(),
 		Category:    NewCategory(n.Category),
 		Tags:        NewTags(n.Tags),
 	}
@@ -17,6 +29,15 @@ func NewNews(n newsportal.News) News {
 	return news
 }
 
+func NewWorkflowState(s newsportal.State) WorkflowState {
+	return WorkflowState{
+		State:       s.String(),
+		StatusID:    int(s),
+		Public:      s.Public(),
+		Transitions: newsportal.Map(s.Transitions(), newsportal.State.String),
+	}
+}
+
 func NewNewsSummary(n newsportal.News) NewsSummary {
 	summary := NewsSummary{
 		NewsID:      n.ID,
@@ -26,11 +47,70 @@ func NewNewsSummary(n newsportal.News) NewsSummary {
 		PublishedAt: n.PublishedAt,
 		Category:    NewCategory(n.Category),
 		Tags:        NewTags(n.Tags),
+		Snippet:     n.Snippet,
 	}
 
 	return summary
 }
 
+func NewNewsEvent(subscriptionID int, e newsportal.Event) NewsEvent {
+	return NewsEvent{
+		SubscriptionID: subscriptionID,
+		Event:          string(e.Type),
+		News:           NewNewsSummary(e.News),
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+// NewJSONFeed returns JSON Feed 1.1 document for news. Tag titles are item tags, category is _category extension.
+func NewJSONFeed(list newsportal.NewsList) JSONFeed {
+	feed := JSONFeed{
+		Version:     jsonFeedVersion,
+		Title:       feedTitle,
+		Description: feedDescription,
+		Items:       make([]JSONFeedItem, 0, len(list)),
+	}
+
+	for _, n := range list {
+		item := JSONFeedItem{
+			ID:            strconv.Itoa(n.ID),
+			Title:         n.Title,
+			Summary:       n.Excerpt(feedExcerptLen),
+			DatePublished: n.PublishedAt,
+			DateModified:  n.UpdatedAt,
+			Tags:          make([]string, len(n.Tags)),
+		}
+
+		if n.Content != nil {
+			item.ContentText = *n.Content
+		}
+		if n.Author != "" {
+			item.Authors = []JSONFeedAuthor{{Name: n.Author}}
+		}
+		if n.Category.ID != 0 {
+			category := NewCategory(n.Category)
+			item.Category = &category
+		}
+		for i, t := range n.Tags {
+			item.Tags[i] = t.Title
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed
+}
+
+func NewNewsPage(p newsportal.NewsPage) NewsPage {
+	return NewsPage{
+		Items:    NewNewsSummaries(p.Items),
+		Total:    p.Total,
+		Page:     p.Page,
+		PageSize: p.PageSize,
+		HasNext:  p.HasNext,
+	}
+}
+
 func NewCategory(c newsportal.Category) Category {
 	return Category{
 		CategoryID: c.ID,
@@ -45,3 +125,94 @@ func NewTag(t newsportal.Tag) Tag {
 		StatusID: t.StatusID,
 	}
 }
+
+func NewNewsRevision(r newsportal.NewsRevision) NewsRevision {
+	revision := NewsRevision{
+		NewsRevisionID: r.ID,
+		NewsID:         r.NewsID,
+		CategoryID:     r.CategoryID,
+		Title:          r.Title,
+		TagIDs:         r.TagIDs,
+		Actor:          r.Actor,
+		CreatedAt:      r.CreatedAt,
+	}
+
+	if r.Content != nil {
+		revision.Content = *r.Content
+	}
+
+	return revision
+}
+
+func NewNewsRevisionDiff(d newsportal.NewsRevisionDiff) NewsRevisionDiff {
+	fields := make(map[string]Change, len(d.Fields))
+	for field, change := range d.Fields {
+		fields[field] = Change{Old: change.Old, New: change.New}
+	}
+
+	return NewsRevisionDiff{
+		From:    NewNewsRevision(d.From),
+		To:      NewNewsRevision(d.To),
+		Fields:  fields,
+		Content: newsportal.Map(d.Content, NewDiffLine),
+	}
+}
+
+func NewDiffLine(l newsportal.DiffLine) DiffLine {
+	return DiffLine{Op: string(l.Op), Text: l.Text}
+}
+
+func NewAuditLog(l newsportal.AuditLog) AuditLog {
+	return AuditLog{
+		AuditLogID: l.ID,
+		Actor:      l.Actor,
+		Entity:     l.Entity,
+		EntityID:   l.EntityID,
+		Action:     l.Action,
+		Diff:       l.Diff,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+func NewAPIKey(k newsportal.APIKey) APIKey {
+	return APIKey{
+		APIKeyID:  k.ID,
+		Title:     k.Title,
+		Role:      k.Role,
+		CreatedAt: k.CreatedAt,
+		ExpiresAt: k.ExpiresAt,
+	}
+}
+
+func NewPrincipal(p newsportal.Principal) Principal {
+	return Principal{
+		Subject:  p.Subject,
+		Role:     string(p.Role),
+		APIKeyID: p.APIKeyID,
+	}
+}
+
+func NewWebhook(w newsportal.Webhook) Webhook {
+	return Webhook{
+		WebhookID:  w.ID,
+		URL:        w.URL,
+		EventTypes: w.EventTypes,
+		CreatedAt:  w.CreatedAt,
+		StatusID:   w.StatusID,
+	}
+}
+
+func NewWebhookDelivery(d newsportal.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		WebhookDeliveryID: d.ID,
+		WebhookID:         d.WebhookID,
+		EventType:         d.EventType,
+		Payload:           d.Payload,
+		State:             d.State,
+		Attempts:          d.Attempts,
+		NextAttemptAt:     d.NextAttemptAt,
+		LastError:         d.LastError,
+		CreatedAt:         d.CreatedAt,
+		DeliveredAt:       d.DeliveredAt,
+	}
+}
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+// newManagerError converts newsportal validation, not found, permission and state transition errors to zenrpc errors.
+func newManagerError(err error, notFound string) error {
+	var vErr newsportal.ValidationError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &vErr):
+		return &zenrpc.Error{Code: 400, Message: "validation failed", Data: newFieldErrors(vErr.Fields)}
+	case errors.Is(err, newsportal.ErrNotFound):
+		return zenrpc.NewStringError(404, notFound)
+	case errors.Is(err, newsportal.ErrInvalidTransition):
+		return zenrpc.NewStringError(409, err.Error())
+	case errors.Is(err, newsportal.ErrForbidden):
+		return zenrpc.NewStringError(403, err.Error())
+	}
+
+	return err
+}
+
+func newFieldErrors(fields map[string]string) []FieldError {
+	r := make([]FieldError, 0, len(fields))
+	for field, reason := range fields {
+		r = append(r, FieldError{Field: field, Error: reason})
+	}
+
+	sort.Slice(r, func(i, j int) bool { return r[i].Field < r[j].Field })
+
+	return r
+}
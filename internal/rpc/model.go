@@ -15,6 +15,8 @@ type NewsFilter struct {
 	Page *int `json:"page,omitempty"`
 	//pageSize=10 items per page
 	PageSize *int `json:"pageSize,omitempty"`
+	//cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 func (f NewsFilter) ToModel() *newsportal.NewsFilter {
@@ -24,6 +26,45 @@ func (f NewsFilter) ToModel() *newsportal.NewsFilter {
 	}
 }
 
+type NewsInput struct {
+	//categoryId enabled category ID
+	CategoryID int `json:"categoryId"`
+	//title news title, up to 255 chars
+	Title string `json:"title"`
+	//content news content
+	Content string `json:"content"`
+	//author news author, up to 50 chars
+	Author string `json:"author"`
+	//publishedAt publication time
+	PublishedAt time.Time `json:"publishedAt"`
+	//tagIds enabled tag IDs
+	TagIDs []int `json:"tagIds"`
+	//statusId 4 - draft, 5 - in review, 6 - scheduled, 1 - published, 7 - archived; create makes draft without it and allows scheduled and published for admin only, update keeps current state
+	StatusID int `json:"statusId,omitempty"`
+}
+
+func (n NewsInput) ToModel() newsportal.News {
+	news := newsportal.News{}
+	news.CategoryID = n.CategoryID
+	news.Title = n.Title
+	news.Content = &n.Content
+	news.Author = n.Author
+	news.PublishedAt = n.PublishedAt
+	news.TagIDs = n.TagIDs
+	news.StatusID = n.StatusID
+
+	if news.TagIDs == nil {
+		news.TagIDs = []int{}
+	}
+
+	return news
+}
+
+type FieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
 type Category struct {
 	CategoryID int    `json:"categoryId"`
 	Title      string `json:"title"`
@@ -42,8 +83,10 @@ type News struct {
 	Content     string    `json:"content"`
 	Author      string    `json:"author"`
 	PublishedAt time.Time `json:"publishedAt"`
-	Category    Category  `json:"category"`
-	Tags        []Tag     `json:"tags"`
+	//state workflow state: draft, inReview, scheduled, published or archived
+	State    string   `json:"state"`
+	Category Category `json:"category"`
+	Tags     []Tag    `json:"tags"`
 }
 
 type NewsSummary struct {
@@ -54,4 +97,197 @@ type NewsSummary struct {
 	PublishedAt time.Time `json:"publishedAt"`
 	Category    Category  `json:"category"`
 	Tags        []Tag     `json:"tags"`
+	Snippet     *string   `json:"snippet,omitempty"`
+}
+
+// NewsPage is a news page with total count of news matching filter.
+type NewsPage struct {
+	Items    []NewsSummary `json:"items"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"pageSize"`
+	HasNext  bool          `json:"hasNext"`
+}
+
+// NewsCursorPage is a news page of cursor pagination.
+type NewsCursorPage struct {
+	Items []NewsSummary `json:"items"`
+	//nextCursor cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// NewsEvent is a params of news.event notification sent to WebSocket subscribers.
+type NewsEvent struct {
+	SubscriptionID int `json:"subscriptionId"`
+	//event news.published or news.updated
+	Event     string      `json:"event"`
+	News      NewsSummary `json:"news"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+// JSONFeed is a JSON Feed 1.1 document.
+type JSONFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Items       []JSONFeedItem `json:"items"`
+}
+
+type JSONFeedItem struct {
+	ID            string           `json:"id"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary,omitempty"`
+	DatePublished time.Time        `json:"date_published"`
+	DateModified  *time.Time       `json:"date_modified,omitempty"`
+	Authors       []JSONFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+	//_category news category, JSON Feed extension
+	Category *Category `json:"_category,omitempty"`
+}
+
+type JSONFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type NewsRevision struct {
+	NewsRevisionID int       `json:"newsRevisionId"`
+	NewsID         int       `json:"newsId"`
+	CategoryID     int       `json:"categoryId"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	TagIDs         []int     `json:"tagIds"`
+	Actor          string    `json:"actor"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type NewsRevisionDiff struct {
+	From NewsRevision `json:"from"`
+	To   NewsRevision `json:"to"`
+	//fields changed title, categoryId and tagIds
+	Fields map[string]Change `json:"fields"`
+	//content line diff of content
+	Content []DiffLine `json:"content"`
+}
+
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+type DiffLine struct {
+	//op = - unchanged, + - inserted, - - deleted line
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// WorkflowState is a news workflow state with allowed transitions.
+type WorkflowState struct {
+	State    string `json:"state"`
+	StatusID int    `json:"statusId"`
+	//public news in state are visible on the portal
+	Public      bool     `json:"public"`
+	Transitions []string `json:"transitions"`
+}
+
+type AuditLog struct {
+	AuditLogID int    `json:"auditLogId"`
+	Actor      string `json:"actor"`
+	Entity     string `json:"entity"`
+	EntityID   int    `json:"entityId"`
+	Action     string `json:"action"`
+	//diff changed columns as {"column": {"old": value, "new": value}}
+	Diff      map[string]any `json:"diff"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+type AuditLogFilter struct {
+	//entity optional entity: news, category or tag
+	Entity *string `json:"entity,omitempty"`
+	//entityId optional entity ID
+	EntityID *int `json:"entityId,omitempty"`
+	//from optional start of time range, inclusive
+	From *time.Time `json:"from,omitempty"`
+	//to optional end of time range, exclusive
+	To *time.Time `json:"to,omitempty"`
+	//page=1 page number (1-based)
+	Page *int `json:"page,omitempty"`
+	//pageSize=10 items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+func (f AuditLogFilter) ToModel() newsportal.AuditLogFilter {
+	return newsportal.AuditLogFilter{
+		Entity:   f.Entity,
+		EntityID: f.EntityID,
+		From:     f.From,
+		To:       f.To,
+	}
+}
+
+type APIKey struct {
+	APIKeyID  int        `json:"apiKeyId"`
+	Title     string     `json:"title"`
+	Role      string     `json:"role"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type APIKeyInput struct {
+	//title API key title
+	Title string `json:"title"`
+	//role reader, editor or admin
+	Role string `json:"role"`
+	//expiresAt optional expiration time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// CreatedAPIKey is a new API key with key value, which is returned only once.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type Principal struct {
+	Subject  string `json:"subject"`
+	Role     string `json:"role"`
+	APIKeyID *int   `json:"apiKeyId,omitempty"`
+}
+
+type Webhook struct {
+	WebhookID  int       `json:"webhookId"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	CreatedAt  time.Time `json:"createdAt"`
+	StatusID   int       `json:"statusId"`
+}
+
+type WebhookInput struct {
+	//url http or https URL receiving POST requests
+	URL string `json:"url"`
+	//eventTypes news.published, news.updated or news.deleted
+	EventTypes []string `json:"eventTypes"`
+	//secret optional HMAC secret of payload signatures, generated if empty
+	Secret string `json:"secret,omitempty"`
+}
+
+// CreatedWebhook is a new webhook with secret, which is returned only once.
+type CreatedWebhook struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+type WebhookDelivery struct {
+	WebhookDeliveryID int    `json:"webhookDeliveryId"`
+	WebhookID         int    `json:"webhookId"`
+	EventType         string `json:"eventType"`
+	//payload news data sent in webhook request
+	Payload map[string]any `json:"payload"`
+	//state pending, delivered or dead
+	State         string     `json:"state"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	LastError     *string    `json:"lastError,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DeliveredAt   *time.Time `json:"deliveredAt,omitempty"`
 }
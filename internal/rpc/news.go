@@ -13,17 +13,30 @@ import (
 type NewsService struct {
 	zenrpc.Service
 	manager *newsportal.Manager
+	stream  *newsportal.NewsStream
 }
 
-func NewNewsService(manager *newsportal.Manager) *NewsService {
-	return &NewsService{manager: manager}
+// NewNewsService returns news service, Subscribe is enabled with non-nil stream.
+func NewNewsService(manager *newsportal.Manager, stream *newsportal.NewsStream) *NewsService {
+	return &NewsService{manager: manager, stream: stream}
 }
 
 // List retrieves news with optional filtering by tagId and categoryId, with pagination.
-// Returns NewsSummary (without content) sorted by publishedAt DESC.
+// Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC.
+// With cursor page is ignored, use ListByCursor to get the next page cursor.
 //
+//zenrpc:400 validation failed
 //zenrpc:500 internal server error
 func (s *NewsService) List(ctx context.Context, filter NewsFilter) ([]NewsSummary, error) {
+	if filter.Cursor != nil {
+		page, err := s.ListByCursor(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		return page.Items, nil
+	}
+
 	newsportalSummaries, err := s.manager.NewsByFilter(
 		ctx,
 		filter.TagID,
@@ -35,6 +48,94 @@ func (s *NewsService) List(ctx context.Context, filter NewsFilter) ([]NewsSummar
 	return NewNewsSummaries(newsportalSummaries), err
 }
 
+// Page retrieves news page as List together with total count of news matching filter, as Count returns.
+// List and count are loaded in one transaction.
+//
+//zenrpc:500 internal server error
+func (s *NewsService) Page(ctx context.Context, filter NewsFilter) (*NewsPage, error) {
+	page, err := s.manager.NewsPage(
+		ctx,
+		filter.TagID,
+		filter.CategoryID,
+		filter.Page,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := NewNewsPage(*page)
+	return &result, nil
+}
+
+// ListByCursor retrieves news with optional filtering by tagId and categoryId after cursor.
+// Empty or absent cursor starts from the latest news, page is ignored.
+// Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC, and the next page cursor.
+//
+//zenrpc:400 validation failed
+//zenrpc:500 internal server error
+func (s *NewsService) ListByCursor(ctx context.Context, filter NewsFilter) (*NewsCursorPage, error) {
+	var cursor string
+	if filter.Cursor != nil {
+		cursor = *filter.Cursor
+	}
+
+	list, next, err := s.manager.NewsByCursor(ctx, filter.TagID, filter.CategoryID, cursor, filter.PageSize)
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	page := NewsCursorPage{Items: NewNewsSummaries(list)}
+	if next != "" {
+		page.NextCursor = &next
+	}
+
+	return &page, nil
+}
+
+// Search retrieves news matching full-text query with optional filtering by tagId and categoryId, with pagination.
+// Returns NewsSummary (without content) ranked by relevance, then sorted by publishedAt DESC, newsId DESC.
+//
+//zenrpc:query search query, supports quoted phrases, "or" and "-"
+//zenrpc:400 validation failed
+//zenrpc:500 internal server error
+func (s *NewsService) Search(ctx context.Context, query string, filter NewsFilter) ([]NewsSummary, error) {
+	newsportalSummaries, err := s.manager.SearchNews(
+		ctx,
+		query,
+		filter.TagID,
+		filter.CategoryID,
+		filter.Page,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	return NewNewsSummaries(newsportalSummaries), nil
+}
+
+// Feed returns JSON Feed 1.1 document of news with optional filtering by tagId and categoryId, with pagination.
+// Items are sorted by publishedAt DESC, tag titles are in tags, category is in _category extension.
+//
+//zenrpc:return JSON Feed document
+//zenrpc:500 internal server error
+func (s *NewsService) Feed(ctx context.Context, filter NewsFilter) (*JSONFeed, error) {
+	list, err := s.manager.NewsByFilter(
+		ctx,
+		filter.TagID,
+		filter.CategoryID,
+		filter.Page,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := NewJSONFeed(list)
+	return &feed, nil
+}
+
 // Count returns the count of news matching the optional tagId and categoryId filters.
 //
 //zenrpc:return count of news items
@@ -101,3 +202,236 @@ func (s *NewsService) Tags(ctx context.Context) ([]Tag, error) {
 
 	return NewTags(tags), nil
 }
+
+// Create adds a news item. Category and tags must exist and be enabled.
+// News are created as drafts, only admin can create scheduled and published news.
+//
+//zenrpc:news news data
+//zenrpc:return created news
+//zenrpc:400 validation failed
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *NewsService) Create(ctx context.Context, news NewsInput) (*News, error) {
+	created, err := s.manager.CreateNews(ctx, news.ToModel())
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	result := NewNews(*created)
+	return &result, nil
+}
+
+// Update replaces all editable fields of a news item. Category and tags must exist and be enabled.
+// State change must be an allowed workflow transition, only admin can schedule and publish news.
+//
+//zenrpc:id news numeric ID
+//zenrpc:news news data
+//zenrpc:return updated news
+//zenrpc:400 validation failed
+//zenrpc:403 forbidden
+//zenrpc:404 news not found
+//zenrpc:409 transition is not allowed
+//zenrpc:500 internal server error
+func (s *NewsService) Update(ctx context.Context, id int, news NewsInput) (*News, error) {
+	if id <= 0 {
+		return nil, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	in := news.ToModel()
+	in.ID = id
+
+	updated, err := s.manager.UpdateNews(ctx, in)
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	result := NewNews(*updated)
+	return &result, nil
+}
+
+// Delete marks a news item as deleted.
+//
+//zenrpc:id news numeric ID
+//zenrpc:400 id must be positive
+//zenrpc:404 news not found
+//zenrpc:500 internal server error
+func (s *NewsService) Delete(ctx context.Context, id int) (bool, error) {
+	if id <= 0 {
+		return false, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	if err := s.manager.DeleteNews(ctx, id); err != nil {
+		return false, newManagerError(err, "news not found")
+	}
+
+	return true, nil
+}
+
+// AuditLog returns editorial changes of news, categories and tags, the newest first.
+// Each entry contains actor, action and changed columns with old and new values.
+//
+//zenrpc:filter audit log filter
+//zenrpc:400 validation failed
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *NewsService) AuditLog(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error) {
+	list, err := s.manager.AuditLog(ctx, filter.ToModel(), filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, newManagerError(err, "audit log not found")
+	}
+
+	return NewAuditLogs(list), nil
+}
+
+// Revisions returns revisions of a news item, the newest first. Revision is added on every create and update.
+//
+//zenrpc:id news numeric ID
+//zenrpc:page=1 page number (1-based)
+//zenrpc:pageSize=10 items per page
+//zenrpc:400 id must be positive
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 news not found
+//zenrpc:500 internal server error
+func (s *NewsService) Revisions(ctx context.Context, id int, page, pageSize *int) ([]NewsRevision, error) {
+	if id <= 0 {
+		return nil, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	list, err := s.manager.NewsRevisions(ctx, id, page, pageSize)
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	return NewNewsRevisions(list), nil
+}
+
+// Revision returns a revision of a news item.
+//
+//zenrpc:id news numeric ID
+//zenrpc:revisionId revision numeric ID
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 revision not found
+//zenrpc:500 internal server error
+func (s *NewsService) Revision(ctx context.Context, id, revisionId int) (*NewsRevision, error) {
+	revision, err := s.manager.NewsRevision(ctx, id, revisionId)
+	if err != nil {
+		return nil, newManagerError(err, "revision not found")
+	}
+
+	result := NewNewsRevision(*revision)
+	return &result, nil
+}
+
+// RevisionDiff returns changed title, category and tags and line diff of content between two revisions of a news item.
+//
+//zenrpc:id news numeric ID
+//zenrpc:fromRevisionId old revision numeric ID
+//zenrpc:toRevisionId new revision numeric ID
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 revision not found
+//zenrpc:500 internal server error
+func (s *NewsService) RevisionDiff(ctx context.Context, id, fromRevisionId, toRevisionId int) (*NewsRevisionDiff, error) {
+	diff, err := s.manager.NewsRevisionDiff(ctx, id, fromRevisionId, toRevisionId)
+	if err != nil {
+		return nil, newManagerError(err, "revision not found")
+	}
+
+	result := NewNewsRevisionDiff(*diff)
+	return &result, nil
+}
+
+// RestoreRevision updates a news item with title, content, category and tags of revision. It adds a new revision.
+//
+//zenrpc:id news numeric ID
+//zenrpc:revisionId revision numeric ID
+//zenrpc:return updated news
+//zenrpc:400 validation failed
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 news or revision not found
+//zenrpc:500 internal server error
+func (s *NewsService) RestoreRevision(ctx context.Context, id, revisionId int) (*News, error) {
+	restored, err := s.manager.RestoreNewsRevision(ctx, id, revisionId)
+	if err != nil {
+		return nil, newManagerError(err, "news or revision not found")
+	}
+
+	result := NewNews(*restored)
+	return &result, nil
+}
+
+// Workflow returns news workflow states with allowed transitions.
+func (s *NewsService) Workflow(ctx context.Context) []WorkflowState {
+	return newsportal.Map(newsportal.States, NewWorkflowState)
+}
+
+// Transition moves a news item to workflow state. Publishing sets publishedAt to now if it is in the future,
+// scheduling requires publishedAt in the future. Only admin can schedule and publish news.
+//
+//zenrpc:id news numeric ID
+//zenrpc:state target state: draft, inReview, scheduled, published or archived
+//zenrpc:return news in new state
+//zenrpc:400 validation failed
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 news not found
+//zenrpc:409 transition is not allowed
+//zenrpc:500 internal server error
+func (s *NewsService) Transition(ctx context.Context, id int, state string) (*News, error) {
+	to, ok := newsportal.ParseState(state)
+	if !ok {
+		return nil, zenrpc.NewStringError(400, "unknown state")
+	}
+
+	news, err := s.manager.TransitionNews(ctx, id, to)
+	if err != nil {
+		return nil, newManagerError(err, "news not found")
+	}
+
+	result := NewNews(*news)
+	return &result, nil
+}
+
+// Subscribe subscribes WebSocket connection of /rpc/ws to published and updated news with optional filtering by
+// tagId and categoryId. Events are sent as news.event notifications with NewsEvent params until Unsubscribe is called
+// or connection is closed.
+//
+//zenrpc:tagId optional tag filter
+//zenrpc:categoryId optional category filter
+//zenrpc:return subscription ID
+//zenrpc:400 subscriptions require WebSocket connection
+//zenrpc:503 news stream is disabled
+func (s *NewsService) Subscribe(ctx context.Context, tagId, categoryId *int) (int, error) {
+	if s.stream == nil {
+		return 0, zenrpc.NewStringError(503, "news stream is disabled")
+	}
+
+	conn, ok := wsConnFromContext(ctx)
+	if !ok {
+		return 0, zenrpc.NewStringError(400, "subscriptions require WebSocket connection")
+	}
+
+	return conn.subscribe(s.stream, newsportal.NewsFilter{TagID: tagId, CategoryID: categoryId}), nil
+}
+
+// Unsubscribe removes subscription of WebSocket connection.
+//
+//zenrpc:subscriptionId subscription ID returned by Subscribe
+//zenrpc:400 subscriptions require WebSocket connection
+//zenrpc:404 subscription not found
+func (s *NewsService) Unsubscribe(ctx context.Context, subscriptionId int) (bool, error) {
+	conn, ok := wsConnFromContext(ctx)
+	if !ok {
+		return false, zenrpc.NewStringError(400, "subscriptions require WebSocket connection")
+	}
+
+	if !conn.unsubscribe(subscriptionId) {
+		return false, zenrpc.NewStringError(404, "subscription not found")
+	}
+
+	return true, nil
+}
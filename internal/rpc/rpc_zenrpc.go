@@ -11,23 +11,278 @@ import (
 )
 
 var RPC = struct {
-	NewsService struct{ List, Count, ByID, Categories, Tags string }
+	AuthService    struct{ Principal, APIKeys, CreateAPIKey, DeleteAPIKey string }
+	NewsService    struct{ List, Page, ListByCursor, Search, Feed, Count, ByID, Categories, Tags, Create, Update, Delete, AuditLog, Revisions, Revision, RevisionDiff, RestoreRevision, Workflow, Transition, Subscribe, Unsubscribe string }
+	WebhookService struct{ List, Create, Disable, Deliveries, Redeliver string }
 }{
-	NewsService: struct{ List, Count, ByID, Categories, Tags string }{
+	AuthService: struct{ Principal, APIKeys, CreateAPIKey, DeleteAPIKey string }{
+		Principal:    "principal",
+		APIKeys:      "apikeys",
+		CreateAPIKey: "createapikey",
+		DeleteAPIKey: "deleteapikey",
+	},
+	NewsService: struct{ List, Page, ListByCursor, Search, Feed, Count, ByID, Categories, Tags, Create, Update, Delete, AuditLog, Revisions, Revision, RevisionDiff, RestoreRevision, Workflow, Transition, Subscribe, Unsubscribe string }{
+		List:            "list",
+		Page:            "page",
+		ListByCursor:    "listbycursor",
+		Search:          "search",
+		Feed:            "feed",
+		Count:           "count",
+		ByID:            "byid",
+		Categories:      "categories",
+		Tags:            "tags",
+		Create:          "create",
+		Update:          "update",
+		Delete:          "delete",
+		AuditLog:        "auditlog",
+		Revisions:       "revisions",
+		Revision:        "revision",
+		RevisionDiff:    "revisiondiff",
+		RestoreRevision: "restorerevision",
+		Workflow:        "workflow",
+		Transition:      "transition",
+		Subscribe:       "subscribe",
+		Unsubscribe:     "unsubscribe",
+	},
+	WebhookService: struct{ List, Create, Disable, Deliveries, Redeliver string }{
 		List:       "list",
-		Count:      "count",
-		ByID:       "byid",
-		Categories: "categories",
-		Tags:       "tags",
+		Create:     "create",
+		Disable:    "disable",
+		Deliveries: "deliveries",
+		Redeliver:  "redeliver",
 	},
 }
 
+func (AuthService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Principal": {
+				Description: `Principal returns authenticated client.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "Principal",
+					Properties: smd.PropertyList{
+						{
+							Name: "subject",
+							Type: smd.String,
+						},
+						{
+							Name: "role",
+							Type: smd.String,
+						},
+						{
+							Name:     "apiKeyId",
+							Optional: true,
+							Type:     smd.Integer,
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "unauthorized",
+				},
+			},
+			"APIKeys": {
+				Description: `APIKeys returns API keys, the newest first. Key values are not returned.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]APIKey",
+					Items: map[string]string{
+						"$ref": "#/definitions/APIKey",
+					},
+					Definitions: map[string]smd.Definition{
+						"APIKey": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "apiKeyId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "role",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "expiresAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "unauthorized",
+					403: "forbidden",
+					500: "internal server error",
+				},
+			},
+			"CreateAPIKey": {
+				Description: `CreateAPIKey adds API key with role. Key value is returned only once.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "apiKey",
+						Description: `API key data`,
+						Type:        smd.Object,
+						TypeName:    "APIKeyInput",
+						Properties: smd.PropertyList{
+							{
+								Name:        "title",
+								Description: `title API key title`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "role",
+								Description: `role reader, editor or admin`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "expiresAt",
+								Optional:    true,
+								Description: `expiresAt optional expiration time`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `created API key with key value`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "CreatedAPIKey",
+					Properties: smd.PropertyList{
+						{
+							Name: "apiKeyId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "role",
+							Type: smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name:     "expiresAt",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "key",
+							Type: smd.String,
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					401: "unauthorized",
+					403: "forbidden",
+					500: "internal server error",
+				},
+			},
+			"DeleteAPIKey": {
+				Description: `DeleteAPIKey marks API key as deleted.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `API key numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type: smd.Boolean,
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "api key not found",
+					500: "internal server error",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s AuthService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.AuthService.Principal:
+		resp.Set(s.Principal(ctx))
+
+	case RPC.AuthService.APIKeys:
+		resp.Set(s.APIKeys(ctx))
+
+	case RPC.AuthService.CreateAPIKey:
+		var args = struct {
+			ApiKey APIKeyInput `json:"apiKey"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"apiKey"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.CreateAPIKey(ctx, args.ApiKey))
+
+	case RPC.AuthService.DeleteAPIKey:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DeleteAPIKey(ctx, args.Id))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
 func (NewsService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
 			"List": {
 				Description: `List retrieves news with optional filtering by tagId and categoryId, with pagination.
-Returns NewsSummary (without content) sorted by publishedAt DESC.`,
+Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC.
+With cursor page is ignored, use ListByCursor to get the next page cursor.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:     "filter",
@@ -58,6 +313,12 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 								Description: `pageSize=10 items per page`,
 								Type:        smd.Integer,
 							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
 						},
 					},
 				},
@@ -103,6 +364,11 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 										"$ref": "#/definitions/Tag",
 									},
 								},
+								{
+									Name:     "snippet",
+									Optional: true,
+									Type:     smd.String,
+								},
 							},
 						},
 						"Category": {
@@ -138,11 +404,13 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 					},
 				},
 				Errors: map[int]string{
+					400: "validation failed",
 					500: "internal server error",
 				},
 			},
-			"Count": {
-				Description: `Count returns the count of news matching the optional tagId and categoryId filters.`,
+			"Page": {
+				Description: `Page retrieves news page as List together with total count of news matching filter, as Count returns.
+List and count are loaded in one transaction.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:     "filter",
@@ -173,69 +441,87 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 								Description: `pageSize=10 items per page`,
 								Type:        smd.Integer,
 							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
 						},
 					},
 				},
-				Returns: smd.JSONSchema{
-					Description: `count of news items`,
-					Type:        smd.Integer,
-				},
-				Errors: map[int]string{
-					500: "internal server error",
-				},
-			},
-			"ByID": {
-				Description: `ByID retrieves a single news item by ID with full content, category and tags.`,
-				Parameters: []smd.JSONSchema{
-					{
-						Name:        "id",
-						Description: `news numeric ID`,
-						Type:        smd.Integer,
-					},
-				},
 				Returns: smd.JSONSchema{
 					Optional: true,
 					Type:     smd.Object,
-					TypeName: "News",
+					TypeName: "NewsPage",
 					Properties: smd.PropertyList{
 						{
-							Name: "newsId",
-							Type: smd.Integer,
+							Name: "items",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/NewsSummary",
+							},
 						},
 						{
-							Name: "categoryId",
+							Name: "total",
 							Type: smd.Integer,
 						},
 						{
-							Name: "title",
-							Type: smd.String,
-						},
-						{
-							Name: "content",
-							Type: smd.String,
-						},
-						{
-							Name: "author",
-							Type: smd.String,
-						},
-						{
-							Name: "publishedAt",
-							Type: smd.String,
+							Name: "page",
+							Type: smd.Integer,
 						},
 						{
-							Name: "category",
-							Ref:  "#/definitions/Category",
-							Type: smd.Object,
+							Name: "pageSize",
+							Type: smd.Integer,
 						},
 						{
-							Name: "tags",
-							Type: smd.Array,
-							Items: map[string]string{
-								"$ref": "#/definitions/Tag",
-							},
+							Name: "hasNext",
+							Type: smd.Boolean,
 						},
 					},
 					Definitions: map[string]smd.Definition{
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "author",
+									Type: smd.String,
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name: "category",
+									Ref:  "#/definitions/Category",
+									Type: smd.Object,
+								},
+								{
+									Name: "tags",
+									Type: smd.Array,
+									Items: map[string]string{
+										"$ref": "#/definitions/Tag",
+									},
+								},
+								{
+									Name:     "snippet",
+									Optional: true,
+									Type:     smd.String,
+								},
+							},
+						},
 						"Category": {
 							Type: "object",
 							Properties: smd.PropertyList{
@@ -269,72 +555,2245 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 					},
 				},
 				Errors: map[int]string{
-					400: "id must be positive",
-					404: "news not found",
 					500: "internal server error",
 				},
 			},
-			"Categories": {
-				Description: `Categories retrieves all categories ordered by orderNumber.`,
+			"ListByCursor": {
+				Description: `ListByCursor retrieves news with optional filtering by tagId and categoryId after cursor.
+Empty or absent cursor starts from the latest news, page is ignored.
+Returns NewsSummary (without content) sorted by publishedAt DESC, newsId DESC, and the next page cursor.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "filter",
+						Type:     smd.Object,
+						TypeName: "NewsFilter",
+						Properties: smd.PropertyList{
+							{
+								Name:        "tagId",
+								Optional:    true,
+								Description: `tagId optional tag filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "categoryId",
+								Optional:    true,
+								Description: `categoryId optional category filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "page",
+								Optional:    true,
+								Description: `page=1 page number (1-based)`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Optional:    true,
+								Description: `pageSize=10 items per page`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "NewsCursorPage",
+					Properties: smd.PropertyList{
+						{
+							Name: "items",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/NewsSummary",
+							},
+						},
+						{
+							Name:        "nextCursor",
+							Optional:    true,
+							Description: `nextCursor cursor of the next page, absent on the last page`,
+							Type:        smd.String,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "author",
+									Type: smd.String,
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name: "category",
+									Ref:  "#/definitions/Category",
+									Type: smd.Object,
+								},
+								{
+									Name: "tags",
+									Type: smd.Array,
+									Items: map[string]string{
+										"$ref": "#/definitions/Tag",
+									},
+								},
+								{
+									Name:     "snippet",
+									Optional: true,
+									Type:     smd.String,
+								},
+							},
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					500: "internal server error",
+				},
+			},
+			"Search": {
+				Description: `Search retrieves news matching full-text query with optional filtering by tagId and categoryId, with pagination.
+Returns NewsSummary (without content) ranked by relevance, then sorted by publishedAt DESC, newsId DESC.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "query",
+						Description: `search query, supports quoted phrases, "or" and "-"`,
+						Type:        smd.String,
+					},
+					{
+						Name:     "filter",
+						Type:     smd.Object,
+						TypeName: "NewsFilter",
+						Properties: smd.PropertyList{
+							{
+								Name:        "tagId",
+								Optional:    true,
+								Description: `tagId optional tag filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "categoryId",
+								Optional:    true,
+								Description: `categoryId optional category filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "page",
+								Optional:    true,
+								Description: `page=1 page number (1-based)`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Optional:    true,
+								Description: `pageSize=10 items per page`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]NewsSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "author",
+									Type: smd.String,
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name: "category",
+									Ref:  "#/definitions/Category",
+									Type: smd.Object,
+								},
+								{
+									Name: "tags",
+									Type: smd.Array,
+									Items: map[string]string{
+										"$ref": "#/definitions/Tag",
+									},
+								},
+								{
+									Name:     "snippet",
+									Optional: true,
+									Type:     smd.String,
+								},
+							},
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					500: "internal server error",
+				},
+			},
+			"Feed": {
+				Description: `Feed returns JSON Feed 1.1 document of news with optional filtering by tagId and categoryId, with pagination.
+Items are sorted by publishedAt DESC, tag titles are in tags, category is in _category extension.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "filter",
+						Type:     smd.Object,
+						TypeName: "NewsFilter",
+						Properties: smd.PropertyList{
+							{
+								Name:        "tagId",
+								Optional:    true,
+								Description: `tagId optional tag filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "categoryId",
+								Optional:    true,
+								Description: `categoryId optional category filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "page",
+								Optional:    true,
+								Description: `page=1 page number (1-based)`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Optional:    true,
+								Description: `pageSize=10 items per page`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `JSON Feed document`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "JSONFeed",
+					Properties: smd.PropertyList{
+						{
+							Name: "version",
+							Type: smd.String,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "description",
+							Type: smd.String,
+						},
+						{
+							Name: "items",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/JSONFeedItem",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"JSONFeedItem": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "content_text",
+									Type: smd.String,
+								},
+								{
+									Name: "summary",
+									Type: smd.String,
+								},
+								{
+									Name: "date_published",
+									Type: smd.String,
+								},
+								{
+									Name:     "date_modified",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "authors",
+									Type: smd.Array,
+									Items: map[string]string{
+										"$ref": "#/definitions/JSONFeedAuthor",
+									},
+								},
+								{
+									Name: "tags",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name:        "_category",
+									Optional:    true,
+									Description: `_category news category, JSON Feed extension`,
+									Ref:         "#/definitions/Category",
+									Type:        smd.Object,
+								},
+							},
+						},
+						"JSONFeedAuthor": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "name",
+									Type: smd.String,
+								},
+							},
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "internal server error",
+				},
+			},
+			"Count": {
+				Description: `Count returns the count of news matching the optional tagId and categoryId filters.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "filter",
+						Type:     smd.Object,
+						TypeName: "NewsFilter",
+						Properties: smd.PropertyList{
+							{
+								Name:        "tagId",
+								Optional:    true,
+								Description: `tagId optional tag filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "categoryId",
+								Optional:    true,
+								Description: `categoryId optional category filter`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "page",
+								Optional:    true,
+								Description: `page=1 page number (1-based)`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Optional:    true,
+								Description: `pageSize=10 items per page`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "cursor",
+								Optional:    true,
+								Description: `cursor opaque cursor from nextCursor, page is ignored when set; empty string starts from the latest news`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `count of news items`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "internal server error",
+				},
+			},
+			"ByID": {
+				Description: `ByID retrieves a single news item by ID with full content, category and tags.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "author",
+							Type: smd.String,
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name:        "state",
+							Description: `state workflow state: draft, inReview, scheduled, published or archived`,
+							Type:        smd.String,
+						},
+						{
+							Name: "category",
+							Ref:  "#/definitions/Category",
+							Type: smd.Object,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/Tag",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					404: "news not found",
+					500: "internal server error",
+				},
+			},
+			"Categories": {
+				Description: `Categories retrieves all categories ordered by orderNumber.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]Category",
+					Items: map[string]string{
+						"$ref": "#/definitions/Category",
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					404: "categories not found",
+					500: "internal server error",
+				},
+			},
+			"Tags": {
+				Description: `Tags retrieves all tags ordered by title.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]Tag",
+					Items: map[string]string{
+						"$ref": "#/definitions/Tag",
+					},
+					Definitions: map[string]smd.Definition{
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					404: "tags not found",
+					500: "internal server error",
+				},
+			},
+			"Create": {
+				Description: `Create adds a news item. Category and tags must exist and be enabled.
+News are created as drafts, only admin can create scheduled and published news.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "news",
+						Description: `news data`,
+						Type:        smd.Object,
+						TypeName:    "NewsInput",
+						Properties: smd.PropertyList{
+							{
+								Name:        "categoryId",
+								Description: `categoryId enabled category ID`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "title",
+								Description: `title news title, up to 255 chars`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "content",
+								Description: `content news content`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "author",
+								Description: `author news author, up to 50 chars`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedAt",
+								Description: `publishedAt publication time`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "tagIds",
+								Description: `tagIds enabled tag IDs`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "statusId",
+								Description: `statusId 4 - draft, 5 - in review, 6 - scheduled, 1 - published, 7 - archived; create makes draft without it and allows scheduled and published for admin only, update keeps current state`,
+								Type:        smd.Integer,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `created news`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "author",
+							Type: smd.String,
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name:        "state",
+							Description: `state workflow state: draft, inReview, scheduled, published or archived`,
+							Type:        smd.String,
+						},
+						{
+							Name: "category",
+							Ref:  "#/definitions/Category",
+							Type: smd.Object,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/Tag",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					403: "forbidden",
+					500: "internal server error",
+				},
+			},
+			"Update": {
+				Description: `Update replaces all editable fields of a news item. Category and tags must exist and be enabled.
+State change must be an allowed workflow transition, only admin can schedule and publish news.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "news",
+						Description: `news data`,
+						Type:        smd.Object,
+						TypeName:    "NewsInput",
+						Properties: smd.PropertyList{
+							{
+								Name:        "categoryId",
+								Description: `categoryId enabled category ID`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "title",
+								Description: `title news title, up to 255 chars`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "content",
+								Description: `content news content`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "author",
+								Description: `author news author, up to 50 chars`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedAt",
+								Description: `publishedAt publication time`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "tagIds",
+								Description: `tagIds enabled tag IDs`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "statusId",
+								Description: `statusId 4 - draft, 5 - in review, 6 - scheduled, 1 - published, 7 - archived; create makes draft without it and allows scheduled and published for admin only, update keeps current state`,
+								Type:        smd.Integer,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `updated news`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "author",
+							Type: smd.String,
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name:        "state",
+							Description: `state workflow state: draft, inReview, scheduled, published or archived`,
+							Type:        smd.String,
+						},
+						{
+							Name: "category",
+							Ref:  "#/definitions/Category",
+							Type: smd.Object,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/Tag",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					403: "forbidden",
+					404: "news not found",
+					409: "transition is not allowed",
+					500: "internal server error",
+				},
+			},
+			"Delete": {
+				Description: `Delete marks a news item as deleted.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type: smd.Boolean,
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					404: "news not found",
+					500: "internal server error",
+				},
+			},
+			"AuditLog": {
+				Description: `AuditLog returns editorial changes of news, categories and tags, the newest first.
+Each entry contains actor, action and changed columns with old and new values.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "filter",
+						Description: `audit log filter`,
+						Type:        smd.Object,
+						TypeName:    "AuditLogFilter",
+						Properties: smd.PropertyList{
+							{
+								Name:        "entity",
+								Optional:    true,
+								Description: `entity optional entity: news, category or tag`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "entityId",
+								Optional:    true,
+								Description: `entityId optional entity ID`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "from",
+								Optional:    true,
+								Description: `from optional start of time range, inclusive`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "to",
+								Optional:    true,
+								Description: `to optional end of time range, exclusive`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "page",
+								Optional:    true,
+								Description: `page=1 page number (1-based)`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Optional:    true,
+								Description: `pageSize=10 items per page`,
+								Type:        smd.Integer,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]AuditLog",
+					Items: map[string]string{
+						"$ref": "#/definitions/AuditLog",
+					},
+					Definitions: map[string]smd.Definition{
+						"AuditLog": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "auditLogId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "actor",
+									Type: smd.String,
+								},
+								{
+									Name: "entity",
+									Type: smd.String,
+								},
+								{
+									Name: "entityId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "action",
+									Type: smd.String,
+								},
+								{
+									Name:        "diff",
+									Description: `diff changed columns as {"column": {"old": value, "new": value}}`,
+									Type:        smd.Object,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					401: "unauthorized",
+					403: "forbidden",
+					500: "internal server error",
+				},
+			},
+			"Revisions": {
+				Description: `Revisions returns revisions of a news item, the newest first. Revision is added on every create and update.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "page",
+						Optional:    true,
+						Description: `page number (1-based)`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "pageSize",
+						Optional:    true,
+						Description: `items per page`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]NewsRevision",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsRevision",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsRevision": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "newsRevisionId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "content",
+									Type: smd.String,
+								},
+								{
+									Name: "tagIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "actor",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "news not found",
+					500: "internal server error",
+				},
+			},
+			"Revision": {
+				Description: `Revision returns a revision of a news item.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "revisionId",
+						Description: `revision numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "NewsRevision",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsRevisionId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "tagIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "actor",
+							Type: smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "unauthorized",
+					403: "forbidden",
+					404: "revision not found",
+					500: "internal server error",
+				},
+			},
+			"RevisionDiff": {
+				Description: `RevisionDiff returns changed title, category and tags and line diff of content between two revisions of a news item.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "fromRevisionId",
+						Description: `old revision numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "toRevisionId",
+						Description: `new revision numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "NewsRevisionDiff",
+					Properties: smd.PropertyList{
+						{
+							Name: "from",
+							Ref:  "#/definitions/NewsRevision",
+							Type: smd.Object,
+						},
+						{
+							Name: "to",
+							Ref:  "#/definitions/NewsRevision",
+							Type: smd.Object,
+						},
+						{
+							Name:        "fields",
+							Description: `fields changed title, categoryId and tagIds`,
+							Ref:         "#/definitions/Change",
+							Type:        smd.Object,
+						},
+						{
+							Name:        "content",
+							Description: `content line diff of content`,
+							Type:        smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/DiffLine",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsRevision": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "newsRevisionId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "content",
+									Type: smd.String,
+								},
+								{
+									Name: "tagIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "actor",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+							},
+						},
+						"Change": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "old",
+									Type: smd.Object,
+								},
+								{
+									Name: "new",
+									Type: smd.Object,
+								},
+							},
+						},
+						"DiffLine": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:        "op",
+									Description: `op = - unchanged, + - inserted, - - deleted line`,
+									Type:        smd.String,
+								},
+								{
+									Name: "text",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "unauthorized",
+					403: "forbidden",
+					404: "revision not found",
+					500: "internal server error",
+				},
+			},
+			"RestoreRevision": {
+				Description: `RestoreRevision updates a news item with title, content, category and tags of revision. It adds a new revision.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "revisionId",
+						Description: `revision numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `updated news`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "author",
+							Type: smd.String,
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name:        "state",
+							Description: `state workflow state: draft, inReview, scheduled, published or archived`,
+							Type:        smd.String,
+						},
+						{
+							Name: "category",
+							Ref:  "#/definitions/Category",
+							Type: smd.Object,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/Tag",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "news or revision not found",
+					500: "internal server error",
+				},
+			},
+			"Workflow": {
+				Description: `Workflow returns news workflow states with allowed transitions.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]WorkflowState",
+					Items: map[string]string{
+						"$ref": "#/definitions/WorkflowState",
+					},
+					Definitions: map[string]smd.Definition{
+						"WorkflowState": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "state",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+								{
+									Name:        "public",
+									Description: `public news in state are visible on the portal`,
+									Type:        smd.Boolean,
+								},
+								{
+									Name: "transitions",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"Transition": {
+				Description: `Transition moves a news item to workflow state. Publishing sets publishedAt to now if it is in the future,
+scheduling requires publishedAt in the future. Only admin can schedule and publish news.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "state",
+						Description: `target state: draft, inReview, scheduled, published or archived`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `news in new state`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "content",
+							Type: smd.String,
+						},
+						{
+							Name: "author",
+							Type: smd.String,
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name:        "state",
+							Description: `state workflow state: draft, inReview, scheduled, published or archived`,
+							Type:        smd.String,
+						},
+						{
+							Name: "category",
+							Ref:  "#/definitions/Category",
+							Type: smd.Object,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/Tag",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "tagId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "news not found",
+					409: "transition is not allowed",
+					500: "internal server error",
+				},
+			},
+			"Subscribe": {
+				Description: `Subscribe subscribes WebSocket connection of /rpc/ws to published and updated news with optional filtering by
+tagId and categoryId. Events are sent as news.event notifications with NewsEvent params until Unsubscribe is called
+or connection is closed.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "tagId",
+						Optional:    true,
+						Description: `optional tag filter`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "categoryId",
+						Optional:    true,
+						Description: `optional category filter`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `subscription ID`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					400: "subscriptions require WebSocket connection",
+					503: "news stream is disabled",
+				},
+			},
+			"Unsubscribe": {
+				Description: `Unsubscribe removes subscription of WebSocket connection.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "subscriptionId",
+						Description: `subscription ID returned by Subscribe`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type: smd.Boolean,
+				},
+				Errors: map[int]string{
+					400: "subscriptions require WebSocket connection",
+					404: "subscription not found",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s NewsService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.NewsService.List:
+		var args = struct {
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.List(ctx, args.Filter))
+
+	case RPC.NewsService.Page:
+		var args = struct {
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Page(ctx, args.Filter))
+
+	case RPC.NewsService.ListByCursor:
+		var args = struct {
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.ListByCursor(ctx, args.Filter))
+
+	case RPC.NewsService.Search:
+		var args = struct {
+			Query  string     `json:"query"`
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"query", "filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Search(ctx, args.Query, args.Filter))
+
+	case RPC.NewsService.Feed:
+		var args = struct {
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Feed(ctx, args.Filter))
+
+	case RPC.NewsService.Count:
+		var args = struct {
+			Filter NewsFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Filter))
+
+	case RPC.NewsService.ByID:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.ByID(ctx, args.Id))
+
+	case RPC.NewsService.Categories:
+		resp.Set(s.Categories(ctx))
+
+	case RPC.NewsService.Tags:
+		resp.Set(s.Tags(ctx))
+
+	case RPC.NewsService.Create:
+		var args = struct {
+			News NewsInput `json:"news"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Create(ctx, args.News))
+
+	case RPC.NewsService.Update:
+		var args = struct {
+			Id   int       `json:"id"`
+			News NewsInput `json:"news"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "news"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Update(ctx, args.Id, args.News))
+
+	case RPC.NewsService.Delete:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Delete(ctx, args.Id))
+
+	case RPC.NewsService.AuditLog:
+		var args = struct {
+			Filter AuditLogFilter `json:"filter"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.AuditLog(ctx, args.Filter))
+
+	case RPC.NewsService.Revisions:
+		var args = struct {
+			Id       int  `json:"id"`
+			Page     *int `json:"page"`
+			PageSize *int `json:"pageSize"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "page", "pageSize"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		//zenrpc:page=1 page number (1-based)
+		if args.Page == nil {
+			var v int = 1
+			args.Page = &v
+		}
+
+		//zenrpc:pageSize=10 items per page
+		if args.PageSize == nil {
+			var v int = 10
+			args.PageSize = &v
+		}
+
+		resp.Set(s.Revisions(ctx, args.Id, args.Page, args.PageSize))
+
+	case RPC.NewsService.Revision:
+		var args = struct {
+			Id         int `json:"id"`
+			RevisionId int `json:"revisionId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "revisionId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Revision(ctx, args.Id, args.RevisionId))
+
+	case RPC.NewsService.RevisionDiff:
+		var args = struct {
+			Id             int `json:"id"`
+			FromRevisionId int `json:"fromRevisionId"`
+			ToRevisionId   int `json:"toRevisionId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "fromRevisionId", "toRevisionId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RevisionDiff(ctx, args.Id, args.FromRevisionId, args.ToRevisionId))
+
+	case RPC.NewsService.RestoreRevision:
+		var args = struct {
+			Id         int `json:"id"`
+			RevisionId int `json:"revisionId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "revisionId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RestoreRevision(ctx, args.Id, args.RevisionId))
+
+	case RPC.NewsService.Workflow:
+		resp.Set(s.Workflow(ctx))
+
+	case RPC.NewsService.Transition:
+		var args = struct {
+			Id    int    `json:"id"`
+			State string `json:"state"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "state"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Transition(ctx, args.Id, args.State))
+
+	case RPC.NewsService.Subscribe:
+		var args = struct {
+			TagId      *int `json:"tagId"`
+			CategoryId *int `json:"categoryId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"tagId", "categoryId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Subscribe(ctx, args.TagId, args.CategoryId))
+
+	case RPC.NewsService.Unsubscribe:
+		var args = struct {
+			SubscriptionId int `json:"subscriptionId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"subscriptionId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Unsubscribe(ctx, args.SubscriptionId))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (WebhookService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"List": {
+				Description: `List returns webhooks, the newest first. Secrets are not returned.`,
 				Parameters:  []smd.JSONSchema{},
 				Returns: smd.JSONSchema{
 					Type:     smd.Array,
-					TypeName: "[]Category",
+					TypeName: "[]Webhook",
 					Items: map[string]string{
-						"$ref": "#/definitions/Category",
+						"$ref": "#/definitions/Webhook",
 					},
 					Definitions: map[string]smd.Definition{
-						"Category": {
+						"Webhook": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
-									Name: "categoryId",
+									Name: "webhookId",
 									Type: smd.Integer,
 								},
 								{
-									Name: "title",
+									Name: "url",
+									Type: smd.String,
+								},
+								{
+									Name: "eventTypes",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name: "createdAt",
 									Type: smd.String,
 								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
 							},
 						},
 					},
 				},
 				Errors: map[int]string{
-					404: "categories not found",
+					401: "unauthorized",
+					403: "forbidden",
 					500: "internal server error",
 				},
 			},
-			"Tags": {
-				Description: `Tags retrieves all tags ordered by title.`,
-				Parameters:  []smd.JSONSchema{},
+			"Create": {
+				Description: `Create adds webhook subscribed to event types. Secret is returned only once.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "webhook",
+						Description: `webhook data`,
+						Type:        smd.Object,
+						TypeName:    "WebhookInput",
+						Properties: smd.PropertyList{
+							{
+								Name:        "url",
+								Description: `url http or https URL receiving POST requests`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "eventTypes",
+								Description: `eventTypes news.published, news.updated or news.deleted`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name:        "secret",
+								Description: `secret optional HMAC secret of payload signatures, generated if empty`,
+								Type:        smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `created webhook with secret`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "CreatedWebhook",
+					Properties: smd.PropertyList{
+						{
+							Name: "webhookId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "url",
+							Type: smd.String,
+						},
+						{
+							Name: "eventTypes",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "secret",
+							Type: smd.String,
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "validation failed",
+					401: "unauthorized",
+					403: "forbidden",
+					500: "internal server error",
+				},
+			},
+			"Disable": {
+				Description: `Disable disables webhook, its pending deliveries become dead.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `webhook numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type: smd.Boolean,
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "webhook not found",
+					500: "internal server error",
+				},
+			},
+			"Deliveries": {
+				Description: `Deliveries returns deliveries of webhook, the newest first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `webhook numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "state",
+						Optional:    true,
+						Description: `optional delivery state: pending, delivered or dead`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "page",
+						Optional:    true,
+						Description: `page number (1-based)`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "pageSize",
+						Optional:    true,
+						Description: `items per page`,
+						Type:        smd.Integer,
+					},
+				},
 				Returns: smd.JSONSchema{
 					Type:     smd.Array,
-					TypeName: "[]Tag",
+					TypeName: "[]WebhookDelivery",
 					Items: map[string]string{
-						"$ref": "#/definitions/Tag",
+						"$ref": "#/definitions/WebhookDelivery",
 					},
 					Definitions: map[string]smd.Definition{
-						"Tag": {
+						"WebhookDelivery": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
-									Name: "tagId",
+									Name: "webhookDeliveryId",
 									Type: smd.Integer,
 								},
 								{
-									Name: "title",
+									Name: "webhookId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "eventType",
 									Type: smd.String,
 								},
 								{
-									Name: "statusId",
+									Name:        "payload",
+									Description: `payload news data sent in webhook request`,
+									Type:        smd.Object,
+								},
+								{
+									Name:        "state",
+									Description: `state pending, delivered or dead`,
+									Type:        smd.String,
+								},
+								{
+									Name: "attempts",
 									Type: smd.Integer,
 								},
+								{
+									Name: "nextAttemptAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastError",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "deliveredAt",
+									Optional: true,
+									Type:     smd.String,
+								},
 							},
 						},
 					},
 				},
 				Errors: map[int]string{
-					404: "tags not found",
+					400: "id must be positive",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "webhook not found",
+					500: "internal server error",
+				},
+			},
+			"Redeliver": {
+				Description: `Redeliver queues dead deliveries of webhook for sending again, or one delivery in any state if deliveryId is set.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `webhook numeric ID`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "deliveryId",
+						Optional:    true,
+						Description: `optional delivery numeric ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `number of queued deliveries`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					400: "id must be positive",
+					401: "unauthorized",
+					403: "forbidden",
+					404: "webhook or delivery not found",
 					500: "internal server error",
 				},
 			},
@@ -343,18 +2802,21 @@ Returns NewsSummary (without content) sorted by publishedAt DESC.`,
 }
 
 // Invoke is as generated code from zenrpc cmd
-func (s NewsService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+func (s WebhookService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 	resp := zenrpc.Response{}
 	var err error
 
 	switch method {
-	case RPC.NewsService.List:
+	case RPC.WebhookService.List:
+		resp.Set(s.List(ctx))
+
+	case RPC.WebhookService.Create:
 		var args = struct {
-			Filter NewsFilter `json:"filter"`
+			Webhook WebhookInput `json:"webhook"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"webhook"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -365,15 +2827,15 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.List(ctx, args.Filter))
+		resp.Set(s.Create(ctx, args.Webhook))
 
-	case RPC.NewsService.Count:
+	case RPC.WebhookService.Disable:
 		var args = struct {
-			Filter NewsFilter `json:"filter"`
+			Id int `json:"id"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"filter"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -384,15 +2846,18 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Count(ctx, args.Filter))
+		resp.Set(s.Disable(ctx, args.Id))
 
-	case RPC.NewsService.ByID:
+	case RPC.WebhookService.Deliveries:
 		var args = struct {
-			Id int `json:"id"`
+			Id       int     `json:"id"`
+			State    *string `json:"state"`
+			Page     *int    `json:"page"`
+			PageSize *int    `json:"pageSize"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "state", "page", "pageSize"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -403,13 +2868,39 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.ByID(ctx, args.Id))
+		//zenrpc:page=1 page number (1-based)
+		if args.Page == nil {
+			var v int = 1
+			args.Page = &v
+		}
 
-	case RPC.NewsService.Categories:
-		resp.Set(s.Categories(ctx))
+		//zenrpc:pageSize=10 items per page
+		if args.PageSize == nil {
+			var v int = 10
+			args.PageSize = &v
+		}
 
-	case RPC.NewsService.Tags:
-		resp.Set(s.Tags(ctx))
+		resp.Set(s.Deliveries(ctx, args.Id, args.State, args.Page, args.PageSize))
+
+	case RPC.WebhookService.Redeliver:
+		var args = struct {
+			Id         int  `json:"id"`
+			DeliveryId *int `json:"deliveryId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "deliveryId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Redeliver(ctx, args.Id, args.DeliveryId))
 
 	default:
 		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
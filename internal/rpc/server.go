@@ -8,12 +8,25 @@ import (
 	"github.com/vmkteam/zenrpc/v2"
 )
 
-func New(logger *slog.Logger, newsManager *newsportal.Manager) *zenrpc.Server {
+const newsNamespace = "news"
 
-	rpcService := NewNewsService(newsManager)
+// New returns RPC server with registered services. Middlewares mw are called before logging and auth, e.g. for metrics.
+func New(logger *slog.Logger, newsManager *newsportal.Manager, authManager *newsportal.AuthManager, webhookManager *newsportal.WebhookManager, stream *newsportal.NewsStream, mw ...zenrpc.MiddlewareFunc) *zenrpc.Server {
+
+	rpcService := NewNewsService(newsManager, stream)
 	rpcServer := zenrpc.NewServer(zenrpc.Options{ExposeSMD: true})
-	rpcServer.Register("news", rpcService)
-	rpcServer.Use(middleware.WithSLog(logger.InfoContext, "news-portal", nil))
+	rpcServer.Register(newsNamespace, rpcService)
+	if authManager.Enabled() {
+		rpcServer.Register(authNamespace, NewAuthService(authManager))
+	}
+	if webhookManager != nil {
+		rpcServer.Register(webhookNamespace, NewWebhookService(webhookManager))
+	}
+	rpcServer.Use(mw...)
+	rpcServer.Use(
+		middleware.WithSLog(logger.InfoContext, "news-portal", nil),
+		Auth(authManager),
+	)
 
 	return rpcServer
 }
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const webhookNamespace = "webhook"
+
+func webhookMethod(method string) string {
+	return webhookNamespace + "." + method
+}
+
+// WebhookService provides RPC methods for webhook subscriptions management.
+type WebhookService struct {
+	zenrpc.Service
+	webhooks *newsportal.WebhookManager
+}
+
+func NewWebhookService(webhooks *newsportal.WebhookManager) *WebhookService {
+	return &WebhookService{webhooks: webhooks}
+}
+
+// List returns webhooks, the newest first. Secrets are not returned.
+//
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *WebhookService) List(ctx context.Context) ([]Webhook, error) {
+	list, err := s.webhooks.Webhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWebhooks(list), nil
+}
+
+// Create adds webhook subscribed to event types. Secret is returned only once.
+//
+//zenrpc:webhook webhook data
+//zenrpc:return created webhook with secret
+//zenrpc:400 validation failed
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:500 internal server error
+func (s *WebhookService) Create(ctx context.Context, webhook WebhookInput) (*CreatedWebhook, error) {
+	eventTypes := newsportal.Map(webhook.EventTypes, func(et string) newsportal.EventType { return newsportal.EventType(et) })
+
+	created, err := s.webhooks.CreateWebhook(ctx, webhook.URL, eventTypes, webhook.Secret)
+	if err != nil {
+		return nil, newManagerError(err, "webhook not found")
+	}
+
+	return &CreatedWebhook{Webhook: NewWebhook(*created), Secret: created.Secret}, nil
+}
+
+// Disable disables webhook, its pending deliveries become dead.
+//
+//zenrpc:id webhook numeric ID
+//zenrpc:400 id must be positive
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 webhook not found
+//zenrpc:500 internal server error
+func (s *WebhookService) Disable(ctx context.Context, id int) (bool, error) {
+	if id <= 0 {
+		return false, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	if err := s.webhooks.DisableWebhook(ctx, id); err != nil {
+		return false, newManagerError(err, "webhook not found")
+	}
+
+	return true, nil
+}
+
+// Deliveries returns deliveries of webhook, the newest first.
+//
+//zenrpc:id webhook numeric ID
+//zenrpc:state optional delivery state: pending, delivered or dead
+//zenrpc:page=1 page number (1-based)
+//zenrpc:pageSize=10 items per page
+//zenrpc:400 id must be positive
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 webhook not found
+//zenrpc:500 internal server error
+func (s *WebhookService) Deliveries(ctx context.Context, id int, state *string, page, pageSize *int) ([]WebhookDelivery, error) {
+	if id <= 0 {
+		return nil, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	list, err := s.webhooks.WebhookDeliveries(ctx, id, state, page, pageSize)
+	if err != nil {
+		return nil, newManagerError(err, "webhook not found")
+	}
+
+	return NewWebhookDeliveries(list), nil
+}
+
+// Redeliver queues dead deliveries of webhook for sending again, or one delivery in any state if deliveryId is set.
+//
+//zenrpc:id webhook numeric ID
+//zenrpc:deliveryId optional delivery numeric ID
+//zenrpc:return number of queued deliveries
+//zenrpc:400 id must be positive
+//zenrpc:401 unauthorized
+//zenrpc:403 forbidden
+//zenrpc:404 webhook or delivery not found
+//zenrpc:500 internal server error
+func (s *WebhookService) Redeliver(ctx context.Context, id int, deliveryId *int) (int, error) {
+	if id <= 0 {
+		return 0, zenrpc.NewStringError(400, "id must be positive")
+	}
+
+	n, err := s.webhooks.RedeliverWebhook(ctx, id, deliveryId)
+	if err != nil {
+		return 0, newManagerError(err, "webhook or delivery not found")
+	}
+
+	return n, nil
+}
@@ -0,0 +1,281 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daniilsolovey/news-portal/internal/newsportal"
+	"github.com/gorilla/websocket"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	// newsEventMethod is a method of JSON-RPC notifications with news events sent to subscribers.
+	newsEventMethod = "news.event"
+
+	wsWriteTimeout = 10 * time.Second
+	wsPongTimeout  = 60 * time.Second
+	wsPingPeriod   = wsPongTimeout * 9 / 10
+	wsReadLimit    = MaxRequestSize
+)
+
+// WSHandler serves JSON-RPC 2.0 over WebSocket. Unlike zenrpc.Server.ServeWS it lets methods
+// push notifications to connection, it is used by news subscriptions.
+type WSHandler struct {
+	server   *zenrpc.Server
+	logger   *slog.Logger
+	upgrader websocket.Upgrader
+
+	mu     sync.Mutex
+	conns  map[*wsConn]struct{}
+	closed bool
+}
+
+func NewWSHandler(server *zenrpc.Server, logger *slog.Logger) *WSHandler {
+	return &WSHandler{
+		server: server,
+		logger: logger,
+		conns:  map[*wsConn]struct{}{},
+	}
+}
+
+func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Warn("websocket upgrade failed", "error", err)
+		return
+	}
+
+	conn := newWSConn(c)
+	if !h.add(conn) {
+		conn.closeWith(websocket.CloseGoingAway, "server is shutting down")
+		return
+	}
+	defer h.remove(conn)
+
+	ctx := newWSConnContext(zenrpc.NewRequestContext(r.Context(), r), conn)
+	go conn.ping()
+
+	for {
+		mt, message, err := c.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+				h.logger.Debug("websocket read failed", "error", err)
+			}
+			return
+		}
+
+		data, err := h.server.Do(ctx, message)
+		if err != nil {
+			h.logger.Error("websocket response marshal failed", "error", err)
+			conn.closeWith(websocket.CloseInternalServerErr, "")
+			return
+		} else if bytes.Equal(data, []byte("null")) {
+			// all requests are notifications
+			continue
+		}
+
+		if err := conn.write(mt, data); err != nil {
+			h.logger.Debug("websocket write failed", "error", err)
+			return
+		}
+	}
+}
+
+// Close closes all connections and rejects new ones, subscriptions of connections are removed.
+func (h *WSHandler) Close() {
+	h.mu.Lock()
+	h.closed = true
+	conns := make([]*wsConn, 0, len(h.conns))
+	for c := range h.conns {
+		conns = append(conns, c)
+	}
+	h.mu.Unlock()
+
+	for _, c := range conns {
+		c.closeWith(websocket.CloseGoingAway, "server is shutting down")
+	}
+}
+
+func (h *WSHandler) add(c *wsConn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return false
+	}
+	h.conns[c] = struct{}{}
+
+	return true
+}
+
+// remove removes connection with its subscriptions and closes it.
+func (h *WSHandler) remove(c *wsConn) {
+	h.mu.Lock()
+	delete(h.conns, c)
+	h.mu.Unlock()
+
+	c.unsubscribeAll()
+	c.closeWith(websocket.CloseNormalClosure, "")
+}
+
+// wsConn is a WebSocket connection with news subscriptions.
+type wsConn struct {
+	conn *websocket.Conn
+	done chan struct{}
+	once sync.Once
+
+	writeMu sync.Mutex
+
+	mu     sync.Mutex
+	subs   map[int]*wsSubscription
+	nextID int
+}
+
+// wsSubscription is a news subscription of connection.
+type wsSubscription struct {
+	stream *newsportal.NewsStream
+	sub    *newsportal.Subscription
+}
+
+type wsConnKey struct{}
+
+func newWSConnContext(ctx context.Context, c *wsConn) context.Context {
+	return context.WithValue(ctx, wsConnKey{}, c)
+}
+
+// wsConnFromContext returns WebSocket connection of request.
+func wsConnFromContext(ctx context.Context) (*wsConn, bool) {
+	c, ok := ctx.Value(wsConnKey{}).(*wsConn)
+	return c, ok
+}
+
+func newWSConn(c *websocket.Conn) *wsConn {
+	c.SetReadLimit(wsReadLimit)
+	_ = c.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	c.SetPongHandler(func(string) error {
+		return c.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	return &wsConn{
+		conn: c,
+		done: make(chan struct{}),
+		subs: map[int]*wsSubscription{},
+	}
+}
+
+// write writes message, connection supports one concurrent writer only. Control messages are written concurrently.
+func (c *wsConn) write(mt int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteMessage(mt, data)
+}
+
+// ping sends ping messages until connection is closed, read deadline is extended on pong.
+func (c *wsConn) ping() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// closeWith sends close message and closes connection, read loop returns with error.
+func (c *wsConn) closeWith(code int, text string) {
+	c.once.Do(func() {
+		close(c.done)
+		_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(wsWriteTimeout))
+		_ = c.conn.Close()
+	})
+}
+
+// subscribe subscribes connection to events of news matching filter and returns subscription ID.
+func (c *wsConn) subscribe(stream *newsportal.NewsStream, filter newsportal.NewsFilter) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextID++
+	id := c.nextID
+	ws := &wsSubscription{stream: stream, sub: stream.Subscribe(filter)}
+	c.subs[id] = ws
+
+	go c.forward(id, ws.sub)
+
+	return id
+}
+
+// unsubscribe removes subscription, returns false for unknown subscription.
+func (c *wsConn) unsubscribe(id int) bool {
+	c.mu.Lock()
+	ws, ok := c.subs[id]
+	delete(c.subs, id)
+	c.mu.Unlock()
+
+	if ok {
+		ws.stream.Unsubscribe(ws.sub)
+	}
+
+	return ok
+}
+
+func (c *wsConn) unsubscribeAll() {
+	c.mu.Lock()
+	subs := c.subs
+	c.subs = map[int]*wsSubscription{}
+	c.mu.Unlock()
+
+	for _, ws := range subs {
+		ws.stream.Unsubscribe(ws.sub)
+	}
+}
+
+// forward sends subscription events as notifications. Subscription closed by stream because of
+// stopped stream or slow client closes connection, so client reconnects and subscribes again.
+func (c *wsConn) forward(id int, sub *newsportal.Subscription) {
+	for e := range sub.Events() {
+		data, err := newNewsEventNotification(id, e)
+		if err != nil {
+			continue
+		}
+
+		if err := c.write(websocket.TextMessage, data); err != nil {
+			c.closeWith(websocket.CloseInternalServerErr, "")
+			return
+		}
+	}
+
+	c.mu.Lock()
+	_, active := c.subs[id]
+	c.mu.Unlock()
+
+	if active {
+		c.closeWith(websocket.CloseTryAgainLater, "subscription closed")
+	}
+}
+
+// wsNotification is a JSON-RPC 2.0 notification sent by server.
+type wsNotification struct {
+	Version string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+// newNewsEventNotification returns JSON-RPC notification with news event.
+func newNewsEventNotification(subscriptionID int, e newsportal.Event) ([]byte, error) {
+	return json.Marshal(wsNotification{Version: zenrpc.Version, Method: newsEventMethod, Params: NewNewsEvent(subscriptionID, e)})
+}